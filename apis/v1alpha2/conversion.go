@@ -0,0 +1,138 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// The kinds served in both v1alpha2 and v1beta1 convert through v1beta1, the
+// storage version. While their schemas are identical, conversion is a deep
+// copy; once they diverge, the functions below must map the fields that
+// differ so that no data is lost on a round trip.
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds the conversion functions between v1alpha2 and
+// v1beta1 to s.
+func RegisterConversions(s *runtime.Scheme) error {
+	for _, f := range []struct {
+		a, b interface{}
+		fn   conversion.ConversionFunc
+	}{
+		{(*Gateway)(nil), (*v1beta1.Gateway)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			a.(*Gateway).ConvertTo(b.(*v1beta1.Gateway))
+			return nil
+		}},
+		{(*v1beta1.Gateway)(nil), (*Gateway)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			b.(*Gateway).ConvertFrom(a.(*v1beta1.Gateway))
+			return nil
+		}},
+		{(*GatewayClass)(nil), (*v1beta1.GatewayClass)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			a.(*GatewayClass).ConvertTo(b.(*v1beta1.GatewayClass))
+			return nil
+		}},
+		{(*v1beta1.GatewayClass)(nil), (*GatewayClass)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			b.(*GatewayClass).ConvertFrom(a.(*v1beta1.GatewayClass))
+			return nil
+		}},
+		{(*GRPCRoute)(nil), (*v1beta1.GRPCRoute)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			a.(*GRPCRoute).ConvertTo(b.(*v1beta1.GRPCRoute))
+			return nil
+		}},
+		{(*v1beta1.GRPCRoute)(nil), (*GRPCRoute)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			b.(*GRPCRoute).ConvertFrom(a.(*v1beta1.GRPCRoute))
+			return nil
+		}},
+		{(*HTTPRoute)(nil), (*v1beta1.HTTPRoute)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			a.(*HTTPRoute).ConvertTo(b.(*v1beta1.HTTPRoute))
+			return nil
+		}},
+		{(*v1beta1.HTTPRoute)(nil), (*HTTPRoute)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			b.(*HTTPRoute).ConvertFrom(a.(*v1beta1.HTTPRoute))
+			return nil
+		}},
+		{(*ReferenceGrant)(nil), (*v1beta1.ReferenceGrant)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			a.(*ReferenceGrant).ConvertTo(b.(*v1beta1.ReferenceGrant))
+			return nil
+		}},
+		{(*v1beta1.ReferenceGrant)(nil), (*ReferenceGrant)(nil), func(a, b interface{}, _ conversion.Scope) error {
+			b.(*ReferenceGrant).ConvertFrom(a.(*v1beta1.ReferenceGrant))
+			return nil
+		}},
+	} {
+		if err := s.AddConversionFunc(f.a, f.b, f.fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertTo converts gw to its v1beta1 representation dst.
+func (gw *Gateway) ConvertTo(dst *v1beta1.Gateway) {
+	*dst = v1beta1.Gateway(*gw.DeepCopy())
+}
+
+// ConvertFrom converts src from its v1beta1 representation to gw.
+func (gw *Gateway) ConvertFrom(src *v1beta1.Gateway) {
+	*gw = Gateway(*src.DeepCopy())
+}
+
+// ConvertTo converts gc to its v1beta1 representation dst.
+func (gc *GatewayClass) ConvertTo(dst *v1beta1.GatewayClass) {
+	*dst = v1beta1.GatewayClass(*gc.DeepCopy())
+}
+
+// ConvertFrom converts src from its v1beta1 representation to gc.
+func (gc *GatewayClass) ConvertFrom(src *v1beta1.GatewayClass) {
+	*gc = GatewayClass(*src.DeepCopy())
+}
+
+// ConvertTo converts route to its v1beta1 representation dst.
+func (route *GRPCRoute) ConvertTo(dst *v1beta1.GRPCRoute) {
+	*dst = v1beta1.GRPCRoute(*route.DeepCopy())
+}
+
+// ConvertFrom converts src from its v1beta1 representation to route.
+func (route *GRPCRoute) ConvertFrom(src *v1beta1.GRPCRoute) {
+	*route = GRPCRoute(*src.DeepCopy())
+}
+
+// ConvertTo converts route to its v1beta1 representation dst.
+func (route *HTTPRoute) ConvertTo(dst *v1beta1.HTTPRoute) {
+	*dst = v1beta1.HTTPRoute(*route.DeepCopy())
+}
+
+// ConvertFrom converts src from its v1beta1 representation to route.
+func (route *HTTPRoute) ConvertFrom(src *v1beta1.HTTPRoute) {
+	*route = HTTPRoute(*src.DeepCopy())
+}
+
+// ConvertTo converts rg to its v1beta1 representation dst.
+func (rg *ReferenceGrant) ConvertTo(dst *v1beta1.ReferenceGrant) {
+	*dst = v1beta1.ReferenceGrant(*rg.DeepCopy())
+}
+
+// ConvertFrom converts src from its v1beta1 representation to rg.
+func (rg *ReferenceGrant) ConvertFrom(src *v1beta1.ReferenceGrant) {
+	*rg = ReferenceGrant(*src.DeepCopy())
+}
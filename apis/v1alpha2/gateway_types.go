@@ -137,6 +137,17 @@ type RouteGroupKind = v1beta1.RouteGroupKind
 // +k8s:deepcopy-gen=false
 type GatewayAddress = v1beta1.GatewayAddress
 
+// GatewayInfrastructure defines infrastructure level attributes about a
+// Gateway instance.
+// +k8s:deepcopy-gen=false
+type GatewayInfrastructure = v1beta1.GatewayInfrastructure
+
+// LocalParametersReference identifies an API object containing
+// controller-specific configuration resource within the namespace of the
+// referencing object.
+// +k8s:deepcopy-gen=false
+type LocalParametersReference = v1beta1.LocalParametersReference
+
 // GatewayStatus defines the observed state of Gateway.
 // +k8s:deepcopy-gen=false
 type GatewayStatus = v1beta1.GatewayStatus
@@ -56,6 +56,18 @@ type HTTPRouteSpec = v1beta1.HTTPRouteSpec
 // +k8s:deepcopy-gen=false
 type HTTPRouteRule = v1beta1.HTTPRouteRule
 
+// HTTPRouteRetry defines retry configuration for an HTTPRoute.
+// +k8s:deepcopy-gen=false
+type HTTPRouteRetry = v1beta1.HTTPRouteRetry
+
+// HTTPRouteRetryStatusCode defines an HTTP response status code for
+// which a backend request should be retried.
+//
+// +kubebuilder:validation:Minimum=400
+// +kubebuilder:validation:Maximum=599
+// +k8s:deepcopy-gen=false
+type HTTPRouteRetryStatusCode = v1beta1.HTTPRouteRetryStatusCode
+
 // PathMatchType specifies the semantics of how HTTP paths should be compared.
 // Valid PathMatchType values, along with their conformance level, are:
 //
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// scheme registers both versions and the conversions between them, like the
+// conversion webhook.
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(v1alpha2.Install(scheme))
+	utilruntime.Must(v1beta1.Install(scheme))
+}
+
+// roundTripIterations is the number of fuzzed objects checked per kind and
+// direction.
+const roundTripIterations = 200
+
+// roundTripSeed seeds the fuzzer, so that a failure can be reproduced.
+const roundTripSeed = 1
+
+func TestRoundTripGRPCRoute(t *testing.T) {
+	testRoundTrip(t,
+		func(in *v1alpha2.GRPCRoute) *v1beta1.GRPCRoute {
+			out := &v1beta1.GRPCRoute{}
+			in.ConvertTo(out)
+			return out
+		},
+		func(in *v1beta1.GRPCRoute) *v1alpha2.GRPCRoute {
+			out := &v1alpha2.GRPCRoute{}
+			out.ConvertFrom(in)
+			return out
+		},
+	)
+}
+
+func TestRoundTripHTTPRoute(t *testing.T) {
+	testRoundTrip(t,
+		func(in *v1alpha2.HTTPRoute) *v1beta1.HTTPRoute {
+			out := &v1beta1.HTTPRoute{}
+			in.ConvertTo(out)
+			return out
+		},
+		func(in *v1beta1.HTTPRoute) *v1alpha2.HTTPRoute {
+			out := &v1alpha2.HTTPRoute{}
+			out.ConvertFrom(in)
+			return out
+		},
+	)
+}
+
+func TestRoundTripGateway(t *testing.T) {
+	testRoundTrip(t,
+		func(in *v1alpha2.Gateway) *v1beta1.Gateway {
+			out := &v1beta1.Gateway{}
+			in.ConvertTo(out)
+			return out
+		},
+		func(in *v1beta1.Gateway) *v1alpha2.Gateway {
+			out := &v1alpha2.Gateway{}
+			out.ConvertFrom(in)
+			return out
+		},
+	)
+}
+
+func TestRoundTripGatewayClass(t *testing.T) {
+	testRoundTrip(t,
+		func(in *v1alpha2.GatewayClass) *v1beta1.GatewayClass {
+			out := &v1beta1.GatewayClass{}
+			in.ConvertTo(out)
+			return out
+		},
+		func(in *v1beta1.GatewayClass) *v1alpha2.GatewayClass {
+			out := &v1alpha2.GatewayClass{}
+			out.ConvertFrom(in)
+			return out
+		},
+	)
+}
+
+func TestRoundTripReferenceGrant(t *testing.T) {
+	testRoundTrip(t,
+		func(in *v1alpha2.ReferenceGrant) *v1beta1.ReferenceGrant {
+			out := &v1beta1.ReferenceGrant{}
+			in.ConvertTo(out)
+			return out
+		},
+		func(in *v1beta1.ReferenceGrant) *v1alpha2.ReferenceGrant {
+			out := &v1alpha2.ReferenceGrant{}
+			out.ConvertFrom(in)
+			return out
+		},
+	)
+}
+
+// testRoundTrip fuzzes objects of both versions and verifies that converting
+// them to the other version and back, in memory, through the scheme and through
+// their JSON representation, does not lose any information.
+func testRoundTrip[Alpha, Beta any](t *testing.T, toBeta func(*Alpha) *Beta, toAlpha func(*Beta) *Alpha) {
+	t.Helper()
+
+	seed := int64(roundTripSeed)
+	f := newFuzzer(seed)
+
+	for i := 0; i < roundTripIterations; i++ {
+		alpha := new(Alpha)
+		f.Fuzz(alpha)
+
+		beta := new(Beta)
+		roundTripJSON(t, seed, alpha, beta)
+		if !apiequality.Semantic.DeepEqual(toBeta(alpha), beta) {
+			t.Fatalf("seed %d: v1alpha2 to v1beta1 conversion mismatch:\n%+v\n%+v", seed, toBeta(alpha), beta)
+		}
+
+		alphaOut := new(Alpha)
+		roundTripJSON(t, seed, beta, alphaOut)
+		if !apiequality.Semantic.DeepEqual(alpha, alphaOut) {
+			t.Fatalf("seed %d: v1alpha2 round trip mismatch:\n%+v\n%+v", seed, alpha, alphaOut)
+		}
+		if !apiequality.Semantic.DeepEqual(alpha, toAlpha(toBeta(alpha))) {
+			t.Fatalf("seed %d: v1alpha2 in-memory round trip mismatch", seed)
+		}
+		schemeBeta := new(Beta)
+		if err := scheme.Convert(alpha, schemeBeta, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T with the scheme: %v", seed, alpha, err)
+		}
+		if !apiequality.Semantic.DeepEqual(beta, schemeBeta) {
+			t.Fatalf("seed %d: v1alpha2 to v1beta1 scheme conversion mismatch:\n%+v\n%+v", seed, beta, schemeBeta)
+		}
+
+		beta = new(Beta)
+		f.Fuzz(beta)
+
+		alphaOut = new(Alpha)
+		roundTripJSON(t, seed, beta, alphaOut)
+		betaOut := new(Beta)
+		roundTripJSON(t, seed, alphaOut, betaOut)
+		if !apiequality.Semantic.DeepEqual(beta, betaOut) {
+			t.Fatalf("seed %d: v1beta1 round trip mismatch:\n%+v\n%+v", seed, beta, betaOut)
+		}
+		if !apiequality.Semantic.DeepEqual(beta, toBeta(toAlpha(beta))) {
+			t.Fatalf("seed %d: v1beta1 in-memory round trip mismatch", seed)
+		}
+		schemeAlpha := new(Alpha)
+		if err := scheme.Convert(beta, schemeAlpha, nil); err != nil {
+			t.Fatalf("seed %d: failed to convert %T with the scheme: %v", seed, beta, err)
+		}
+		if !apiequality.Semantic.DeepEqual(alphaOut, schemeAlpha) {
+			t.Fatalf("seed %d: v1beta1 to v1alpha2 scheme conversion mismatch:\n%+v\n%+v", seed, alphaOut, schemeAlpha)
+		}
+	}
+}
+
+// roundTripJSON marshals in and unmarshals the result into out.
+func roundTripJSON(t *testing.T, seed int64, in, out any) {
+	t.Helper()
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("seed %d: failed to marshal %T: %v", seed, in, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatalf("seed %d: failed to unmarshal into %T: %v", seed, out, err)
+	}
+}
+
+// newFuzzer returns a fuzzer which only generates values that survive JSON
+// serialization. Object metadata is limited to the fields relevant to the
+// Gateway API types, and timestamps are truncated to the precision of their
+// serialized form.
+func newFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.NewWithSeed(seed).NilChance(0.2).NumElements(0, 3).Funcs(
+		func(tm *metav1.TypeMeta, c fuzz.Continue) {
+			*tm = metav1.TypeMeta{}
+		},
+		func(om *metav1.ObjectMeta, c fuzz.Continue) {
+			*om = metav1.ObjectMeta{}
+			om.Name = c.RandString()
+			om.Namespace = c.RandString()
+			om.Generation = c.Int63()
+			c.Fuzz(&om.Labels)
+			c.Fuzz(&om.Annotations)
+		},
+		func(tm *metav1.Time, c fuzz.Continue) {
+			*tm = metav1.Unix(c.Int63n(1<<32), 0)
+		},
+	)
+}
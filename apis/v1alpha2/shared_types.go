@@ -47,6 +47,14 @@ type CommonRouteSpec = v1beta1.CommonRouteSpec
 // +kubebuilder:validation:Maximum=65535
 type PortNumber = v1beta1.PortNumber
 
+// Duration is a string value representing a duration in time. The format is
+// as specified in GEP-2257, a strict subset of the syntax parsed by Golang
+// time.ParseDuration.
+//
+// +kubebuilder:validation:Pattern=`^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+// +k8s:deepcopy-gen=false
+type Duration = v1beta1.Duration
+
 // BackendRef defines how a Route should forward a request to a Kubernetes
 // resource.
 //
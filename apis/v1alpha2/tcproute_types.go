@@ -47,6 +47,7 @@ type TCPRouteSpec struct {
 
 	// Rules are a list of TCP matchers and actions.
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	Rules []TCPRouteRule `json:"rules"`
@@ -74,6 +75,7 @@ type TCPRouteRule struct {
 	//
 	// Support for weight: Extended
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
@@ -47,6 +47,7 @@ type UDPRouteSpec struct {
 
 	// Rules are a list of UDP matchers and actions.
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	Rules []UDPRouteRule `json:"rules"`
@@ -74,6 +75,7 @@ type UDPRouteRule struct {
 	//
 	// Support for weight: Extended
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	BackendRefs []BackendRef `json:"backendRefs,omitempty"`
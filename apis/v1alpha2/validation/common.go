@@ -28,24 +28,10 @@ import (
 var validateParentRefs = gatewayvalidationv1b1.ValidateParentRefs
 
 // validateBackendRefServicePort validates whether or not a port was specified
-// for a backendRef which refers to a corev1.Service, asserting that the port
-// field is required.
+// for a backendRef which refers to a corev1.Service or a multi-cluster
+// ServiceImport, asserting that the port field is required.
 func validateBackendRefServicePort(ref *v1a2.BackendRef, path *field.Path) field.ErrorList {
-	var errs field.ErrorList
-
-	if ref.Group != nil && *ref.Group != "" {
-		return nil
-	}
-
-	if ref.Kind != nil && *ref.Kind != "Service" {
-		return nil
-	}
-
-	if ref.Port == nil {
-		errs = append(errs, field.Required(path.Child("port"), "missing port for Service reference"))
-	}
-
-	return errs
+	return gatewayvalidationv1b1.ValidateBackendObjectReferencePort(&ref.BackendObjectReference, path)
 }
 
 func ptrTo[T any](a T) *T {
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
@@ -30,6 +31,9 @@ import (
 //
 // Validation that is not possible with CRD annotations may be added here in the future.
 // See https://github.com/kubernetes-sigs/gateway-api/issues/868 for more information.
-func ValidateGateway(gw *gatewayv1a2.Gateway) field.ErrorList {
-	return gatewayv1b1validation.ValidateGatewaySpec(&gw.Spec, field.NewPath("spec"))
+//
+// Fields that are not part of channel are forbidden, since the API server
+// drops them on installs of that channel.
+func ValidateGateway(gw *gatewayv1a2.Gateway, channel gatewayv1b1validation.Channel) field.ErrorList {
+	return gatewayv1b1validation.ValidateGateway((*gatewayv1b1.Gateway)(gw), channel)
 }
@@ -23,6 +23,7 @@ import (
 
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 func TestValidateGateway(t *testing.T) {
@@ -75,7 +76,7 @@ func TestValidateGateway(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			gw := baseGateway.DeepCopy()
 			tc.mutate(gw)
-			errs := ValidateGateway(gw)
+			errs := ValidateGateway(gw, gatewayv1b1validation.ExperimentalChannel)
 			if len(tc.expectErrsOnFields) != len(errs) {
 				t.Fatalf("Expected %d errors, got %d errors: %v", len(tc.expectErrsOnFields), len(errs), errs)
 			}
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 func TestValidateGRPCRoute(t *testing.T) {
@@ -281,7 +282,7 @@ func TestValidateGRPCRoute(t *testing.T) {
 			t.Parallel()
 
 			route := gatewayv1a2.GRPCRoute{Spec: gatewayv1a2.GRPCRouteSpec{Rules: tc.rules}}
-			errs := ValidateGRPCRoute(&route)
+			errs := ValidateGRPCRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != len(tc.errs) {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), len(tc.errs), errs)
 				t.FailNow()
@@ -372,7 +373,7 @@ func TestValidateGRPCBackendUniqueFilters(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			route := gatewayv1a2.GRPCRoute{Spec: gatewayv1a2.GRPCRouteSpec{Rules: tc.rules}}
-			errs := ValidateGRPCRoute(&route)
+			errs := ValidateGRPCRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -433,7 +434,7 @@ func TestValidateGRPCHeaderMatches(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateGRPCRoute(&route)
+			errs := ValidateGRPCRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(tc.expectErr) == 0 {
 				assert.Emptyf(t, errs, "expected no errors, got %d errors: %s", len(errs), errs)
 			} else {
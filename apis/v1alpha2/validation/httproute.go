@@ -20,12 +20,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 // ValidateHTTPRoute validates HTTPRoute according to the Gateway API specification.
 // For additional details of the HTTPRoute spec, refer to:
 // https://gateway-api.sigs.k8s.io/v1beta1/references/spec/#gateway.networking.k8s.io/v1beta1.HTTPRoute
-func ValidateHTTPRoute(route *gatewayv1a2.HTTPRoute) field.ErrorList {
-	return gatewayv1b1validation.ValidateHTTPRouteSpec(&route.Spec, field.NewPath("spec"))
+//
+// Fields that are not part of channel are forbidden, since the API server
+// drops them on installs of that channel.
+func ValidateHTTPRoute(route *gatewayv1a2.HTTPRoute, channel gatewayv1b1validation.Channel) field.ErrorList {
+	return gatewayv1b1validation.ValidateHTTPRoute((*gatewayv1b1.HTTPRoute)(route), channel)
 }
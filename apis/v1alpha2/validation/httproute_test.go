@@ -25,6 +25,7 @@ import (
 
 	gatewayv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayv1b1validation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 )
 
 func TestValidateHTTPRoute(t *testing.T) {
@@ -422,7 +423,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var errs field.ErrorList
 			route := gatewayv1a2.HTTPRoute{Spec: gatewayv1a2.HTTPRouteSpec{Rules: tc.rules}}
-			errs = ValidateHTTPRoute(&route)
+			errs = ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -504,7 +505,7 @@ func TestValidateHTTPBackendUniqueFilters(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			route := gatewayv1a2.HTTPRoute{Spec: gatewayv1a2.HTTPRouteSpec{Rules: tc.rules}}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -558,7 +559,7 @@ func TestValidateHTTPPathMatch(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -619,7 +620,7 @@ func TestValidateHTTPHeaderMatches(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(tc.expectErr) == 0 {
 				assert.Emptyf(t, errs, "expected no errors, got %d errors: %s", len(errs), errs)
 			} else {
@@ -683,7 +684,7 @@ func TestValidateHTTPQueryParamMatches(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(tc.expectErr) == 0 {
 				assert.Emptyf(t, errs, "expected no errors, got %d errors: %s", len(errs), errs)
 			} else {
@@ -787,7 +788,7 @@ func TestValidateServicePort(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			route := gatewayv1a2.HTTPRoute{Spec: gatewayv1a2.HTTPRouteSpec{Rules: tc.rules}}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -946,7 +947,7 @@ func TestValidateHTTPRouteTypeMatchesField(t *testing.T) {
 					}},
 				},
 			}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, gatewayv1b1validation.ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
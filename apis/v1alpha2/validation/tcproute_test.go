@@ -69,6 +69,29 @@ func TestValidateTCPRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid TCPRoute with ServiceImport backendRef (missing port)",
+			rules: []gatewayv1a2.TCPRouteRule{
+				{
+					BackendRefs: []gatewayv1a2.BackendRef{
+						{
+							BackendObjectReference: gatewayv1a2.BackendObjectReference{
+								Group: ptrTo(gatewayv1a2.Group("multicluster.x-k8s.io")),
+								Kind:  ptrTo(gatewayv1a2.Kind("ServiceImport")),
+								Name:  "backend",
+							},
+						},
+					},
+				},
+			},
+			errs: field.ErrorList{
+				{
+					Type:   field.ErrorTypeRequired,
+					Field:  "spec.rules[0].backendRefs[0].port",
+					Detail: "missing port for ServiceImport reference",
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
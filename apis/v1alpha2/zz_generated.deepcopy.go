@@ -26,79 +26,6 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCBackendRef) DeepCopyInto(out *GRPCBackendRef) {
-	*out = *in
-	in.BackendRef.DeepCopyInto(&out.BackendRef)
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make([]GRPCRouteFilter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCBackendRef.
-func (in *GRPCBackendRef) DeepCopy() *GRPCBackendRef {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCBackendRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCHeaderMatch) DeepCopyInto(out *GRPCHeaderMatch) {
-	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(v1beta1.HeaderMatchType)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCHeaderMatch.
-func (in *GRPCHeaderMatch) DeepCopy() *GRPCHeaderMatch {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCHeaderMatch)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCMethodMatch) DeepCopyInto(out *GRPCMethodMatch) {
-	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(GRPCMethodMatchType)
-		**out = **in
-	}
-	if in.Service != nil {
-		in, out := &in.Service, &out.Service
-		*out = new(string)
-		**out = **in
-	}
-	if in.Method != nil {
-		in, out := &in.Method, &out.Method
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCMethodMatch.
-func (in *GRPCMethodMatch) DeepCopy() *GRPCMethodMatch {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCMethodMatch)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCRoute) DeepCopyInto(out *GRPCRoute) {
 	*out = *in
@@ -126,41 +53,6 @@ func (in *GRPCRoute) DeepCopyObject() runtime.Object {
 	return nil
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCRouteFilter) DeepCopyInto(out *GRPCRouteFilter) {
-	*out = *in
-	if in.RequestHeaderModifier != nil {
-		in, out := &in.RequestHeaderModifier, &out.RequestHeaderModifier
-		*out = new(v1beta1.HTTPHeaderFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ResponseHeaderModifier != nil {
-		in, out := &in.ResponseHeaderModifier, &out.ResponseHeaderModifier
-		*out = new(v1beta1.HTTPHeaderFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.RequestMirror != nil {
-		in, out := &in.RequestMirror, &out.RequestMirror
-		*out = new(v1beta1.HTTPRequestMirrorFilter)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ExtensionRef != nil {
-		in, out := &in.ExtensionRef, &out.ExtensionRef
-		*out = new(v1beta1.LocalObjectReference)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteFilter.
-func (in *GRPCRouteFilter) DeepCopy() *GRPCRouteFilter {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCRouteFilter)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GRPCRouteList) DeepCopyInto(out *GRPCRouteList) {
 	*out = *in
@@ -193,113 +85,6 @@ func (in *GRPCRouteList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCRouteMatch) DeepCopyInto(out *GRPCRouteMatch) {
-	*out = *in
-	if in.Method != nil {
-		in, out := &in.Method, &out.Method
-		*out = new(GRPCMethodMatch)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]GRPCHeaderMatch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteMatch.
-func (in *GRPCRouteMatch) DeepCopy() *GRPCRouteMatch {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCRouteMatch)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCRouteRule) DeepCopyInto(out *GRPCRouteRule) {
-	*out = *in
-	if in.Matches != nil {
-		in, out := &in.Matches, &out.Matches
-		*out = make([]GRPCRouteMatch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Filters != nil {
-		in, out := &in.Filters, &out.Filters
-		*out = make([]GRPCRouteFilter, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.BackendRefs != nil {
-		in, out := &in.BackendRefs, &out.BackendRefs
-		*out = make([]GRPCBackendRef, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteRule.
-func (in *GRPCRouteRule) DeepCopy() *GRPCRouteRule {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCRouteRule)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCRouteSpec) DeepCopyInto(out *GRPCRouteSpec) {
-	*out = *in
-	in.CommonRouteSpec.DeepCopyInto(&out.CommonRouteSpec)
-	if in.Hostnames != nil {
-		in, out := &in.Hostnames, &out.Hostnames
-		*out = make([]v1beta1.Hostname, len(*in))
-		copy(*out, *in)
-	}
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = make([]GRPCRouteRule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteSpec.
-func (in *GRPCRouteSpec) DeepCopy() *GRPCRouteSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCRouteSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GRPCRouteStatus) DeepCopyInto(out *GRPCRouteStatus) {
-	*out = *in
-	in.RouteStatus.DeepCopyInto(&out.RouteStatus)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCRouteStatus.
-func (in *GRPCRouteStatus) DeepCopy() *GRPCRouteStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(GRPCRouteStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gateway) DeepCopyInto(out *Gateway) {
 	*out = *in
@@ -17,6 +17,7 @@ limitations under the License.
 // Package v1beta1 contains API Schema definitions for the
 // gateway.networking.k8s.io API group.
 //
+// +k8s:openapi-gen=true
 // +kubebuilder:object:generate=true
 // +groupName=gateway.networking.k8s.io
 package v1beta1
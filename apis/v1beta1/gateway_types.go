@@ -157,10 +157,101 @@ type GatewaySpec struct {
 	//
 	// +optional
 	// <gateway:validateIPAddress>
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="IPAddress values must be unique",rule="self.all(a1, a1.type == 'IPAddress' ? self.exists_one(a2, a2.type == a1.type && a2.value == a1.value) : true )"
 	// +kubebuilder:validation:XValidation:message="Hostname values must be unique",rule="self.all(a1, a1.type == 'Hostname' ? self.exists_one(a2, a2.type == a1.type && a2.value == a1.value) : true )"
 	Addresses []GatewayAddress `json:"addresses,omitempty"`
+
+	// Infrastructure defines infrastructure level attributes about this
+	// Gateway instance.
+	//
+	// Support: Core
+	//
+	// +optional
+	// <gateway:experimental>
+	Infrastructure *GatewayInfrastructure `json:"infrastructure,omitempty"`
+}
+
+// GatewayInfrastructure defines infrastructure level attributes about a
+// Gateway instance.
+type GatewayInfrastructure struct {
+	// Labels that SHOULD be applied to any resources created in response to
+	// this Gateway.
+	//
+	// For implementations creating other Kubernetes objects, this should be
+	// the `metadata.labels` field on resources. For other implementations,
+	// this refers to any relevant (implementation specific) "labels" concepts.
+	//
+	// An implementation may choose to add additional implementation-specific
+	// labels as they see fit. Keys prefixed with `gateway.networking.k8s.io/`
+	// are reserved for use by Gateway API and MUST NOT be specified here.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	// +kubebuilder:validation:XValidation:message="Label keys must not use the reserved gateway.networking.k8s.io/ prefix",rule="self.all(key, !key.startsWith('gateway.networking.k8s.io/'))"
+	// +kubebuilder:validation:XValidation:message="Label keys must be 253 characters or less",rule="self.all(key, key.size() <= 253)"
+	// +kubebuilder:validation:XValidation:message="Label keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters",rule="self.all(key, key.matches('^([a-z0-9]([-a-z0-9]*[a-z0-9])?([.][a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?([A-Za-z0-9][-A-Za-z0-9_.]{0,61})?[A-Za-z0-9]$'))"
+	// +kubebuilder:validation:XValidation:message="Label values must be 63 characters or less and consist of alphanumeric characters, '-', '_' or '.', starting and ending with an alphanumeric character",rule="self.all(key, self[key].matches('^(([A-Za-z0-9][-A-Za-z0-9_.]{0,61})?[A-Za-z0-9])?$'))"
+	Labels map[AnnotationKey]AnnotationValue `json:"labels,omitempty"`
+
+	// Annotations that SHOULD be applied to any resources created in response
+	// to this Gateway.
+	//
+	// For implementations creating other Kubernetes objects, this should be
+	// the `metadata.annotations` field on resources. For other
+	// implementations, this refers to any relevant (implementation specific)
+	// "annotations" concepts.
+	//
+	// An implementation may choose to add additional implementation-specific
+	// annotations as they see fit. Keys prefixed with
+	// `gateway.networking.k8s.io/` are reserved for use by Gateway API and
+	// MUST NOT be specified here. Keys are limited to 253 characters and
+	// values to 4096 characters.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=8
+	// +kubebuilder:validation:XValidation:message="Annotation keys must not use the reserved gateway.networking.k8s.io/ prefix",rule="self.all(key, !key.startsWith('gateway.networking.k8s.io/'))"
+	// +kubebuilder:validation:XValidation:message="Annotation keys must be 253 characters or less",rule="self.all(key, key.size() <= 253)"
+	// +kubebuilder:validation:XValidation:message="Annotation keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters",rule="self.all(key, key.matches('^([a-z0-9]([-a-z0-9]*[a-z0-9])?([.][a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?([A-Za-z0-9][-A-Za-z0-9_.]{0,61})?[A-Za-z0-9]$'))"
+	Annotations map[AnnotationKey]AnnotationValue `json:"annotations,omitempty"`
+
+	// ParametersRef is a reference to a resource that contains the
+	// configuration parameters corresponding to the Gateway. This is optional
+	// if the controller does not require any additional configuration.
+	//
+	// This follows the same semantics as GatewayClass's `parametersRef`, but
+	// on a per-Gateway basis. The referent MUST be in the same namespace as
+	// the Gateway.
+	//
+	// If the referent cannot be found, the Gateway's "Accepted" status
+	// condition SHOULD be set to False with the "InvalidParameters" reason.
+	//
+	// Support: Implementation-specific
+	//
+	// +optional
+	ParametersRef *LocalParametersReference `json:"parametersRef,omitempty"`
+}
+
+// LocalParametersReference identifies an API object containing
+// controller-specific configuration resource within the namespace of the
+// referencing object.
+type LocalParametersReference struct {
+	// Group is the group of the referent.
+	Group Group `json:"group"`
+
+	// Kind is kind of the referent.
+	Kind Kind `json:"kind"`
+
+	// Name is the name of the referent.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
 }
 
 // Listener embodies the concept of a logical endpoint where a Gateway accepts
@@ -353,6 +444,7 @@ type GatewayTLSConfig struct {
 	// Support: Implementation-specific (More than one reference or other resource types)
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=64
 	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
 
@@ -414,6 +506,7 @@ type AllowedRoutes struct {
 	// Support: Core
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=8
 	Kinds []RouteGroupKind `json:"kinds,omitempty"`
 }
@@ -522,6 +615,7 @@ type GatewayStatus struct {
 	//
 	// +optional
 	// <gateway:validateIPAddress>
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	Addresses []GatewayStatusAddress `json:"addresses,omitempty"`
 
@@ -632,6 +726,7 @@ const (
 	// * "NotReconciled"
 	// * "UnsupportedAddress"
 	// * "ListenersNotValid"
+	// * "InvalidParameters"
 	//
 	// Possible reasons for this condition to be Unknown are:
 	//
@@ -665,6 +760,11 @@ const (
 	// * The address is already in use.
 	// * The type of address is not supported by the implementation.
 	GatewayReasonUnsupportedAddress GatewayConditionReason = "UnsupportedAddress"
+
+	// This reason is used with the "Accepted" condition when the
+	// Gateway's infrastructure parametersRef refers to a resource that
+	// doesn't exist or is not supported by the implementation.
+	GatewayReasonInvalidParameters GatewayConditionReason = "InvalidParameters"
 )
 
 const (
@@ -718,6 +818,7 @@ type ListenerStatus struct {
 	// and invalid Route kinds are specified, the implementation MUST
 	// reference the valid Route kinds that have been specified.
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=8
 	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
 
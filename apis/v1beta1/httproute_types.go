@@ -111,12 +111,14 @@ type HTTPRouteSpec struct {
 	// Support: Core
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	Hostnames []Hostname `json:"hostnames,omitempty"`
 
 	// Rules are a list of HTTP matchers, filters and actions.
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:default={{matches: {{path: {type: "PathPrefix", value: "/"}}}}}
 	Rules []HTTPRouteRule `json:"rules,omitempty"`
@@ -190,6 +192,7 @@ type HTTPRouteRule struct {
 	// parent a request is coming from, a HTTP 404 status code MUST be returned.
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=8
 	// +kubebuilder:default={{path:{ type: "PathPrefix", value: "/"}}}
 	Matches []HTTPRouteMatch `json:"matches,omitempty"`
@@ -221,6 +224,7 @@ type HTTPRouteRule struct {
 	// Support: Core
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="May specify either httpRouteFilterRequestRedirect or httpRouteFilterRequestRewrite, but not both",rule="!(self.exists(f, f.type == 'RequestRedirect') && self.exists(f, f.type == 'URLRewrite'))"
 	// +kubebuilder:validation:XValidation:message="RequestHeaderModifier filter cannot be repeated",rule="self.exists(f, f.type == 'RequestHeaderModifier') ? self.exists_one(f, f.type == 'RequestHeaderModifier') : true"
@@ -261,10 +265,100 @@ type HTTPRouteRule struct {
 	// Support for weight: Core
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+
+	// Retry defines the configuration for when to retry an HTTP request
+	// forwarded to a backend. When unspecified, implementations MAY apply
+	// their own default retry behavior.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// <gateway:experimental>
+	Retry *HTTPRouteRetry `json:"retry,omitempty"`
 }
 
+// HTTPRouteRetry defines retry configuration for an HTTPRoute.
+//
+// Implementations SHOULD retry on connection errors (disconnect, reset,
+// timeout, TCP failure) if a retry stanza is configured.
+//
+// +kubebuilder:validation:XValidation:message="attempts must be between 0 and 16",rule="!has(self.attempts) || (self.attempts >= 0 && self.attempts <= 16)"
+// +kubebuilder:validation:XValidation:message="backoff must not be set when attempts is 0",rule="!has(self.backoff) || !has(self.attempts) || self.attempts > 0"
+type HTTPRouteRetry struct {
+	// Attempts specifies the maximum number of times an individual request
+	// from the gateway to a backend should be retried, not counting the
+	// initial attempt. A value of 0 disables retries.
+	//
+	// If the maximum number of retries has been attempted without a
+	// successful response from the backend, the Gateway MUST return an error.
+	//
+	// When this field is unspecified, the number of times to attempt to retry
+	// a backend request is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// PerTryTimeout specifies the maximum duration of each individual attempt,
+	// including the initial attempt, of a request to a backend. An attempt
+	// that exceeds this duration is considered failed and MAY be retried.
+	//
+	// When this field is unspecified, each attempt is only bounded by any
+	// timeout applying to the request as a whole.
+	//
+	// Support: Extended
+	//
+	// +optional
+	PerTryTimeout *Duration `json:"perTryTimeout,omitempty"`
+
+	// Codes defines the HTTP response status codes for which a backend
+	// request should be retried.
+	//
+	// When this field is unspecified, the status codes considered retryable
+	// are implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	Codes []HTTPRouteRetryStatusCode `json:"codes,omitempty"`
+
+	// Backoff specifies the minimum duration a Gateway should wait between
+	// retry attempts. Implementations MAY increase this duration between
+	// subsequent attempts, for example using exponential backoff with
+	// jitter.
+	//
+	// When this field is unspecified, the time to wait between retry attempts
+	// is implementation-specific.
+	//
+	// Support: Extended
+	//
+	// +optional
+	Backoff *Duration `json:"backoff,omitempty"`
+}
+
+// HTTPRouteRetryStatusCode defines an HTTP response status code for
+// which a backend request should be retried.
+//
+// Implementations MUST support the following status codes as retryable:
+//
+// * 500
+// * 502
+// * 503
+// * 504
+//
+// Implementations MAY support specifying additional discrete values in the
+// 400-599 range.
+//
+// +kubebuilder:validation:Minimum=400
+// +kubebuilder:validation:Maximum=599
+type HTTPRouteRetryStatusCode int
+
 // PathMatchType specifies the semantics of how HTTP paths should be compared.
 // Valid PathMatchType values, along with their support levels, are:
 //
@@ -839,6 +933,7 @@ type HTTPHeaderFilter struct {
 	//   my-header2: bar
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	Remove []string `json:"remove,omitempty"`
 }
@@ -1094,6 +1189,7 @@ type HTTPBackendRef struct {
 	// Filters field in HTTPRouteRule.)
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:XValidation:message="May specify either httpRouteFilterRequestRedirect or httpRouteFilterRequestRewrite, but not both",rule="!(self.exists(f, f.type == 'RequestRedirect') && self.exists(f, f.type == 'URLRewrite'))"
 	// +kubebuilder:validation:XValidation:message="May specify either httpRouteFilterRequestRedirect or httpRouteFilterRequestRewrite, but not both",rule="!(self.exists(f, f.type == 'RequestRedirect') && self.exists(f, f.type == 'URLRewrite'))"
@@ -137,8 +137,9 @@ type BackendObjectReference struct {
 	Namespace *Namespace `json:"namespace,omitempty"`
 
 	// Port specifies the destination port number to use for this resource.
-	// Port is required when the referent is a Kubernetes Service. In this
-	// case, the port number is the service port number, not the target port.
+	// Port is required when the referent is a Kubernetes Service or a
+	// multi-cluster ServiceImport. In this case, the port number is the
+	// service port number, not the target port.
 	// For other resources, destination port might be derived from the referent
 	// resource or this field.
 	//
@@ -72,6 +72,7 @@ type ReferenceGrantSpec struct {
 	//
 	// Support: Core
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	From []ReferenceGrantFrom `json:"from"`
@@ -83,6 +84,7 @@ type ReferenceGrantSpec struct {
 	//
 	// Support: Core
 	//
+	// +listType=atomic
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	To []ReferenceGrantTo `json:"to"`
@@ -200,6 +200,7 @@ type CommonRouteSpec struct {
 	// ParentRef of the Route.
 	//
 	// +optional
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=32
 	// <gateway:standard:validation:XValidation:message="sectionName must be unique when parentRefs includes 2 or more references to the same parent",rule="self.all(p1, self.exists_one(p2, p1.group == p2.group && p1.kind == p2.kind && ((!has(p1.__namespace__) && !has(p2.__namespace__)) || (!has(p1.__namespace__) && p2.__namespace__ == '') || (p1.__namespace__ == '' && !has(p2.__namespace__)) || (p1.__namespace__ == p2.__namespace__)) && p1.name == p2.name && ((!has(p1.sectionName) && !has(p2.sectionName)) || (!has(p1.sectionName) && p2.sectionName == '') || (p1.sectionName == '' && !has(p2.sectionName)) || (p1.sectionName == p2.sectionName))))">
 	// <gateway:experimental:validation:XValidation:message="sectionName or port must be unique when parentRefs includes 2 or more references to the same parent",rule="self.all(p1, self.exists_one(p2, p1.group == p2.group && p1.kind == p2.kind && ((!has(p1.__namespace__) && !has(p2.__namespace__)) || (!has(p1.__namespace__) && p2.__namespace__ == '') || (p1.__namespace__ == '' && !has(p2.__namespace__)) || (p1.__namespace__ == p2.__namespace__)) && p1.name == p2.name && ((!has(p1.sectionName) && !has(p2.sectionName)) || (!has(p1.sectionName) && p2.sectionName == '') || (p1.sectionName == '' && !has(p2.sectionName)) || (p1.sectionName == p2.sectionName)) && ((!has(p1.port) && !has(p2.port)) || (!has(p1.port) && p2.port == 0) || (p1.port == 0 && !has(p2.port)) || (p1.port == p2.port))))">
@@ -212,6 +213,13 @@ type CommonRouteSpec struct {
 // +kubebuilder:validation:Maximum=65535
 type PortNumber int32
 
+// Duration is a string value representing a duration in time. The format is
+// as specified in GEP-2257, a strict subset of the syntax parsed by Golang
+// time.ParseDuration.
+//
+// +kubebuilder:validation:Pattern=`^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+type Duration string
+
 // BackendRef defines how a Route should forward a request to a Kubernetes
 // resource.
 //
@@ -411,6 +419,14 @@ type RouteStatus struct {
 	// A maximum of 32 Gateways will be represented in this list. An empty list
 	// means the route has not been attached to any Gateway.
 	//
+	// The list is atomic, so a controller must keep the entries of other
+	// controllers when it writes the list, e.g. by updating the Route with the
+	// resourceVersion it read. Server-side apply can't merge the entries of
+	// several controllers: an entry is identified by the fields of parentRef
+	// and controllerName, but the keys of a list map must be scalar fields of
+	// the list items.
+	//
+	// +listType=atomic
 	// +kubebuilder:validation:MaxItems=32
 	Parents []RouteParentStatus `json:"parents"`
 }
@@ -639,3 +655,33 @@ const (
 	// Support: Implementation-specific
 	NamedAddressType AddressType = "NamedAddress"
 )
+
+// The following values of the Kubernetes Service port `appProtocol` field may
+// be used to describe the protocol spoken by a backend, as described in
+// GEP-1282. When a Service port referenced by a Route sets one of these
+// values, implementations that support the corresponding protocol SHOULD use
+// it when connecting to that backend.
+//
+// gRPC backends served over cleartext HTTP/2 should use AppProtocolH2C.
+const (
+	// AppProtocolH2C indicates that the backend speaks HTTP/2 over
+	// cleartext with prior knowledge, as described in
+	// [RFC 7540](https://www.rfc-editor.org/rfc/rfc7540#section-3.4).
+	//
+	// Support: Extended
+	AppProtocolH2C = "kubernetes.io/h2c"
+
+	// AppProtocolWebSocket indicates that the backend speaks WebSocket over
+	// cleartext, as described in
+	// [RFC 6455](https://www.rfc-editor.org/rfc/rfc6455).
+	//
+	// Support: Extended
+	AppProtocolWebSocket = "kubernetes.io/ws"
+
+	// AppProtocolWebSocketTLS indicates that the backend speaks WebSocket
+	// over TLS, as described in
+	// [RFC 6455](https://www.rfc-editor.org/rfc/rfc6455).
+	//
+	// Support: Implementation-specific
+	AppProtocolWebSocketTLS = "kubernetes.io/wss"
+)
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Channel is a Gateway API release channel.
+type Channel string
+
+const (
+	// StandardChannel is the channel of the stable API fields.
+	StandardChannel Channel = "standard"
+	// ExperimentalChannel is the channel of all API fields, including the
+	// experimental ones.
+	ExperimentalChannel Channel = "experimental"
+)
+
+// validateChannelFields returns a Forbidden error for every experimental
+// field set in obj, a kind of the v1beta1 API, unless channel is the
+// experimental channel. The API server drops these fields on standard-channel
+// installs.
+func validateChannelFields(kind string, obj interface{}, channel Channel) field.ErrorList {
+	var errs field.ErrorList
+	switch channel {
+	case ExperimentalChannel:
+		return nil
+	case StandardChannel:
+	default:
+		return field.ErrorList{field.InternalError(nil, fmt.Errorf("unknown channel %q", channel))}
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+	var content map[string]interface{}
+	if err := json.Unmarshal(data, &content); err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+
+	for _, path := range experimentalFields[kind] {
+		for _, fieldPath := range setFields(content, strings.Split(path, "."), nil) {
+			errs = append(errs, field.Forbidden(fieldPath, fmt.Sprintf("field is experimental and will be dropped on a %s-channel install", channel)))
+		}
+	}
+	return errs
+}
+
+// setFields returns the paths of the fields of content matching segments,
+// where a segment ending in [*] matches every item of a list.
+func setFields(content interface{}, segments []string, path *field.Path) []*field.Path {
+	if len(segments) == 0 {
+		return []*field.Path{path}
+	}
+	object, ok := content.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	name := strings.TrimSuffix(segments[0], "[*]")
+	isList := name != segments[0]
+	value, ok := object[name]
+	if !ok || value == nil {
+		return nil
+	}
+	fieldPath := field.NewPath(name)
+	if path != nil {
+		fieldPath = path.Child(name)
+	}
+	if !isList {
+		return setFields(value, segments[1:], fieldPath)
+	}
+
+	items, _ := value.([]interface{})
+	var paths []*field.Path
+	for i, item := range items {
+		paths = append(paths, setFields(item, segments[1:], fieldPath.Index(i))...)
+	}
+	return paths
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestValidateHTTPRouteChannelFields(t *testing.T) {
+	route := &gatewayv1b1.HTTPRoute{
+		Spec: gatewayv1b1.HTTPRouteSpec{
+			CommonRouteSpec: gatewayv1b1.CommonRouteSpec{
+				ParentRefs: []gatewayv1b1.ParentReference{
+					{Name: "gw"},
+					{Name: "other-gw", Port: ptrTo(gatewayv1b1.PortNumber(80))},
+				},
+			},
+			Rules: []gatewayv1b1.HTTPRouteRule{
+				{Retry: &gatewayv1b1.HTTPRouteRetry{}},
+				{},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		channel    Channel
+		expectErrs []string
+	}{{
+		name:       "standard channel",
+		channel:    StandardChannel,
+		expectErrs: []string{"spec.parentRefs[1].port", "spec.rules[0].retry"},
+	}, {
+		name:    "experimental channel",
+		channel: ExperimentalChannel,
+	}, {
+		name:       "unknown channel",
+		channel:    "stable",
+		expectErrs: []string{"<nil>"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateHTTPRoute(route, tc.channel)
+			if len(errs) != len(tc.expectErrs) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expectErrs), len(errs), errs)
+			}
+			for i, err := range errs {
+				if err.Field != tc.expectErrs[i] {
+					t.Errorf("expected error for field %s, got %s", tc.expectErrs[i], err.Field)
+				}
+				if tc.channel == StandardChannel && err.Type != field.ErrorTypeForbidden {
+					t.Errorf("expected error type %s, got %s", field.ErrorTypeForbidden, err.Type)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateGatewayChannelFields(t *testing.T) {
+	gw := &gatewayv1b1.Gateway{
+		Spec: gatewayv1b1.GatewaySpec{
+			GatewayClassName: "foo",
+		},
+	}
+	if errs := ValidateGateway(gw, StandardChannel); len(errs) != 0 {
+		t.Errorf("expected no errors without experimental fields, got %v", errs)
+	}
+
+	gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{}
+	errs := ValidateGateway(gw, StandardChannel)
+	if len(errs) != 1 || errs[0].Field != "spec.infrastructure" {
+		t.Errorf("expected an error for spec.infrastructure, got %v", errs)
+	}
+	if errs := ValidateGateway(gw, ExperimentalChannel); len(errs) != 0 {
+		t.Errorf("expected no errors on the experimental channel, got %v", errs)
+	}
+}
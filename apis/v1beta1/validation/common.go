@@ -17,12 +17,30 @@ limitations under the License.
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"time"
+
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+const (
+	// multiClusterGroup is the API group of the Multi-Cluster Services API.
+	multiClusterGroup = "multicluster.x-k8s.io"
+	// serviceImportKind is the kind of a multi-cluster ServiceImport.
+	serviceImportKind = "ServiceImport"
+)
+
+var (
+	// validDuration is the GEP-2257 Duration format, a strict subset of the
+	// syntax parsed by time.ParseDuration.
+	validDuration      = `^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+	validDurationRegex = regexp.MustCompile(validDuration)
+)
+
 // ValidateParentRefs validates ParentRefs SectionName must be set and unique
 // when ParentRefs includes 2 or more references to the same parent
 func ValidateParentRefs(parentRefs []gatewayv1b1.ParentReference, path *field.Path) field.ErrorList {
@@ -81,6 +99,51 @@ func ValidateParentRefs(parentRefs []gatewayv1b1.ParentReference, path *field.Pa
 	return errs
 }
 
+// ValidateBackendObjectReferencePort validates that a port is specified for
+// backend references which require one. This covers core Services as well as
+// multi-cluster ServiceImports, see GEP-1748 for more details. ServiceImport
+// references must also use the multicluster.x-k8s.io group.
+func ValidateBackendObjectReferencePort(ref *gatewayv1b1.BackendObjectReference, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	group := ""
+	if ref.Group != nil {
+		group = string(*ref.Group)
+	}
+	kind := "Service"
+	if ref.Kind != nil {
+		kind = string(*ref.Kind)
+	}
+
+	switch {
+	case group == "" && kind == "Service":
+		if ref.Port == nil {
+			errs = append(errs, field.Required(path.Child("port"), "missing port for Service reference"))
+		}
+	case group == "" && kind == serviceImportKind:
+		errs = append(errs, field.Invalid(path.Child("group"), group, "ServiceImport reference must use the "+multiClusterGroup+" group"))
+	case group == multiClusterGroup && kind == serviceImportKind:
+		if ref.Port == nil {
+			errs = append(errs, field.Required(path.Child("port"), "missing port for ServiceImport reference"))
+		}
+	case group == multiClusterGroup:
+		errs = append(errs, field.NotSupported(path.Child("kind"), kind, []string{serviceImportKind}))
+	}
+
+	return errs
+}
+
+// validateDuration validates that d is a GEP-2257 Duration.
+func validateDuration(d gatewayv1b1.Duration, path *field.Path) field.ErrorList {
+	if !validDurationRegex.MatchString(string(d)) {
+		return field.ErrorList{field.Invalid(path, d, fmt.Sprintf("must be a GEP-2257 duration (matching %s)", validDuration))}
+	}
+	if _, err := time.ParseDuration(string(d)); err != nil {
+		return field.ErrorList{field.Invalid(path, d, err.Error())}
+	}
+	return nil
+}
+
 func ptrTo[T any](a T) *T {
 	return &a
 }
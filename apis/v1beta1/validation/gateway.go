@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"net/netip"
 	"regexp"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -47,6 +49,16 @@ var (
 
 	validHostnameAddress = `^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
 	validHostnameRegexp  = regexp.MustCompile(validHostnameAddress)
+
+	// reservedInfrastructurePrefix is the key prefix reserved for labels and
+	// annotations added by Gateway API itself.
+	reservedInfrastructurePrefix = gatewayv1b1.GroupName + "/"
+
+	// validInfrastructureKey is the format of the keys of infrastructure
+	// labels and annotations. It must match the CEL rules on
+	// GatewayInfrastructure, so that the webhook and the CRD agree.
+	validInfrastructureKey       = `^([a-z0-9]([-a-z0-9]*[a-z0-9])?([.][a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?([A-Za-z0-9][-A-Za-z0-9_.]{0,61})?[A-Za-z0-9]$`
+	validInfrastructureKeyRegexp = regexp.MustCompile(validInfrastructureKey)
 )
 
 // ValidateGateway validates gw according to the Gateway API specification.
@@ -56,8 +68,12 @@ var (
 //
 // Validation that is not possible with CRD annotations may be added here in the future.
 // See https://github.com/kubernetes-sigs/gateway-api/issues/868 for more information.
-func ValidateGateway(gw *gatewayv1b1.Gateway) field.ErrorList {
-	return ValidateGatewaySpec(&gw.Spec, field.NewPath("spec"))
+//
+// Fields that are not part of channel are forbidden, since the API server
+// drops them on installs of that channel.
+func ValidateGateway(gw *gatewayv1b1.Gateway, channel Channel) field.ErrorList {
+	errs := ValidateGatewaySpec(&gw.Spec, field.NewPath("spec"))
+	return append(errs, validateChannelFields("Gateway", gw, channel)...)
 }
 
 // ValidateGatewaySpec validates whether required fields of spec are set according to the
@@ -66,6 +82,7 @@ func ValidateGatewaySpec(spec *gatewayv1b1.GatewaySpec, path *field.Path) field.
 	var errs field.ErrorList
 	errs = append(errs, validateGatewayListeners(spec.Listeners, path.Child("listeners"))...)
 	errs = append(errs, validateGatewayAddresses(spec.Addresses, path.Child("addresses"))...)
+	errs = append(errs, validateGatewayInfrastructure(spec.Infrastructure, path.Child("infrastructure"))...)
 	return errs
 }
 
@@ -194,3 +211,41 @@ func validateGatewayAddresses(addresses []gatewayv1b1.GatewayAddress, path *fiel
 	}
 	return errs
 }
+
+// validateGatewayInfrastructure validates that the keys of the labels and
+// annotations of infrastructure are valid, that the label values are valid
+// Kubernetes label values, and that no key uses the prefix reserved for
+// Gateway API.
+func validateGatewayInfrastructure(infra *gatewayv1b1.GatewayInfrastructure, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if infra == nil {
+		return errs
+	}
+	for k, v := range infra.Labels {
+		keyPath := path.Child("labels").Key(string(k))
+		errs = append(errs, validateInfrastructureKey(string(k), keyPath)...)
+		for _, msg := range validation.IsValidLabelValue(string(v)) {
+			errs = append(errs, field.Invalid(keyPath, string(v), msg))
+		}
+	}
+	for k := range infra.Annotations {
+		errs = append(errs, validateInfrastructureKey(string(k), path.Child("annotations").Key(string(k)))...)
+	}
+	return errs
+}
+
+// validateInfrastructureKey validates the key of an infrastructure label or
+// annotation.
+func validateInfrastructureKey(key string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if strings.HasPrefix(key, reservedInfrastructurePrefix) {
+		errs = append(errs, field.Invalid(path, key, fmt.Sprintf("prefix %q is reserved", reservedInfrastructurePrefix)))
+	}
+	if len(key) > validation.DNS1123SubdomainMaxLength {
+		errs = append(errs, field.TooLong(path, key, validation.DNS1123SubdomainMaxLength))
+	}
+	if !validInfrastructureKeyRegexp.MatchString(key) {
+		errs = append(errs, field.Invalid(path, key, fmt.Sprintf("must match %s", validInfrastructureKey)))
+	}
+	return errs
+}
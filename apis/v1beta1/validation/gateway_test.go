@@ -18,9 +18,12 @@ package validation
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -414,6 +417,104 @@ func TestValidateGateway(t *testing.T) {
 				},
 			},
 		},
+		"valid infrastructure labels and annotations": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"example.com/team": "networking",
+					},
+					Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"example.com/owner": "Networking team <networking@example.com>",
+					},
+				}
+			},
+			expectErrs: nil,
+		},
+		"infrastructure label uses reserved prefix": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"gateway.networking.k8s.io/gateway-name": "foo",
+					},
+				}
+			},
+			expectErrs: []field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.infrastructure.labels[gateway.networking.k8s.io/gateway-name]",
+					Detail:   `prefix "gateway.networking.k8s.io/" is reserved`,
+					BadValue: "gateway.networking.k8s.io/gateway-name",
+				},
+			},
+		},
+		"infrastructure annotation uses reserved prefix": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"gateway.networking.k8s.io/foo": "bar",
+					},
+				}
+			},
+			expectErrs: []field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.infrastructure.annotations[gateway.networking.k8s.io/foo]",
+					Detail:   `prefix "gateway.networking.k8s.io/" is reserved`,
+					BadValue: "gateway.networking.k8s.io/foo",
+				},
+			},
+		},
+		"infrastructure label value is invalid": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"example.com/team": "networking team",
+					},
+				}
+			},
+			expectErrs: []field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.infrastructure.labels[example.com/team]",
+					Detail:   validation.IsValidLabelValue("networking team")[0],
+					BadValue: "networking team",
+				},
+			},
+		},
+		"infrastructure annotation key is invalid": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"example.com/owner team": "networking",
+					},
+				}
+			},
+			expectErrs: []field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.infrastructure.annotations[example.com/owner team]",
+					Detail:   fmt.Sprintf("must match %s", validInfrastructureKey),
+					BadValue: "example.com/owner team",
+				},
+			},
+		},
+		"infrastructure annotation key has an uppercase prefix": {
+			mutate: func(gw *gatewayv1b1.Gateway) {
+				gw.Spec.Infrastructure = &gatewayv1b1.GatewayInfrastructure{
+					Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+						"Example.com/owner": "networking",
+					},
+				}
+			},
+			expectErrs: []field.Error{
+				{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.infrastructure.annotations[Example.com/owner]",
+					Detail:   fmt.Sprintf("must match %s", validInfrastructureKey),
+					BadValue: "Example.com/owner",
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -421,7 +522,7 @@ func TestValidateGateway(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			gw := baseGateway.DeepCopy()
 			tc.mutate(gw)
-			errs := ValidateGateway(gw)
+			errs := ValidateGateway(gw, ExperimentalChannel)
 			if len(tc.expectErrs) != len(errs) {
 				t.Fatalf("Expected %d errors, got %d errors: %v", len(tc.expectErrs), len(errs), errs)
 			}
@@ -442,3 +543,16 @@ func TestValidateGateway(t *testing.T) {
 		})
 	}
 }
+
+func TestValidInfrastructureKeyMatchesCEL(t *testing.T) {
+	types, err := os.ReadFile("../gateway_types.go")
+	if err != nil {
+		t.Fatalf("Error reading Gateway types: %v", err)
+	}
+	for _, kind := range []string{"Label", "Annotation"} {
+		rule := fmt.Sprintf(`+kubebuilder:validation:XValidation:message="%s keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters",rule="self.all(key, key.matches('%s'))"`, kind, validInfrastructureKey)
+		if !strings.Contains(string(types), rule) {
+			t.Errorf("Expected the CEL rule on infrastructure %s keys to use %s", strings.ToLower(kind), validInfrastructureKey)
+		}
+	}
+}
@@ -41,13 +41,21 @@ var (
 
 	// All valid path characters per RFC-3986
 	validPathCharacters = "^(?:[A-Za-z0-9\\/\\-._~!$&'()*+,;=:@]|[%][0-9a-fA-F]{2})+$"
+
+	// maxHTTPRouteRetryAttempts is the maximum number of retries that can be
+	// configured for an HTTPRoute rule.
+	maxHTTPRouteRetryAttempts int32 = 16
 )
 
 // ValidateHTTPRoute validates HTTPRoute according to the Gateway API specification.
 // For additional details of the HTTPRoute spec, refer to:
 // https://gateway-api.sigs.k8s.io/v1beta1/references/spec/#gateway.networking.k8s.io/v1beta1.HTTPRoute
-func ValidateHTTPRoute(route *gatewayv1b1.HTTPRoute) field.ErrorList {
-	return ValidateHTTPRouteSpec(&route.Spec, field.NewPath("spec"))
+//
+// Fields that are not part of channel are forbidden, since the API server
+// drops them on installs of that channel.
+func ValidateHTTPRoute(route *gatewayv1b1.HTTPRoute, channel Channel) field.ErrorList {
+	errs := ValidateHTTPRouteSpec(&route.Spec, field.NewPath("spec"))
+	return append(errs, validateChannelFields("HTTPRoute", route, channel)...)
 }
 
 // ValidateHTTPRouteSpec validates that required fields of spec are set according to the
@@ -73,6 +81,9 @@ func ValidateHTTPRouteSpec(spec *gatewayv1b1.HTTPRouteSpec, path *field.Path) fi
 				errs = append(errs, validateHTTPQueryParamMatches(m.QueryParams, matchPath.Child("queryParams"))...)
 			}
 		}
+		if rule.Retry != nil {
+			errs = append(errs, validateHTTPRouteRetry(rule.Retry, path.Child("rules").Index(i).Child("retry"))...)
+		}
 	}
 	errs = append(errs, validateHTTPRouteBackendServicePorts(spec.Rules, path.Child("rules"))...)
 	errs = append(errs, ValidateParentRefs(spec.ParentRefs, path.Child("spec"))...)
@@ -90,27 +101,48 @@ func validateRequestRedirectFiltersWithBackendRefs(rule gatewayv1b1.HTTPRouteRul
 	return errs
 }
 
-// validateHTTPRouteBackendServicePorts validates that v1.Service backends always have a port.
+// validateHTTPRouteBackendServicePorts validates that v1.Service and
+// multi-cluster ServiceImport backends always have a port.
 func validateHTTPRouteBackendServicePorts(rules []gatewayv1b1.HTTPRouteRule, path *field.Path) field.ErrorList {
 	var errs field.ErrorList
 
 	for i, rule := range rules {
-		path = path.Index(i).Child("backendRefs")
-		for i, ref := range rule.BackendRefs {
-			if ref.BackendObjectReference.Group != nil &&
-				*ref.BackendObjectReference.Group != "" {
-				continue
-			}
+		for j, ref := range rule.BackendRefs {
+			errs = append(errs, ValidateBackendObjectReferencePort(&ref.BackendObjectReference, path.Index(i).Child("backendRefs").Index(j))...)
+		}
+	}
 
-			if ref.BackendObjectReference.Kind != nil &&
-				*ref.BackendObjectReference.Kind != "Service" {
-				continue
-			}
+	return errs
+}
 
-			if ref.BackendObjectReference.Port == nil {
-				errs = append(errs, field.Required(path.Index(i).Child("port"), "missing port for Service reference"))
-			}
+// validateHTTPRouteRetry validates that the number of retry attempts is
+// bounded, that durations use the GEP-2257 format and that retryable status
+// codes are unique error codes.
+func validateHTTPRouteRetry(retry *gatewayv1b1.HTTPRouteRetry, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if retry.Attempts != nil && (*retry.Attempts < 0 || *retry.Attempts > maxHTTPRouteRetryAttempts) {
+		errs = append(errs, field.Invalid(path.Child("attempts"), *retry.Attempts, fmt.Sprintf("must be between 0 and %d", maxHTTPRouteRetryAttempts)))
+	}
+	if retry.Backoff != nil && retry.Attempts != nil && *retry.Attempts == 0 {
+		errs = append(errs, field.Invalid(path.Child("backoff"), *retry.Backoff, "must not be set when attempts is 0"))
+	}
+	if retry.PerTryTimeout != nil {
+		errs = append(errs, validateDuration(*retry.PerTryTimeout, path.Child("perTryTimeout"))...)
+	}
+	if retry.Backoff != nil {
+		errs = append(errs, validateDuration(*retry.Backoff, path.Child("backoff"))...)
+	}
+
+	codes := map[gatewayv1b1.HTTPRouteRetryStatusCode]bool{}
+	for i, code := range retry.Codes {
+		if code < 400 || code > 599 {
+			errs = append(errs, field.Invalid(path.Child("codes").Index(i), code, "must be between 400 and 599"))
+		}
+		if codes[code] {
+			errs = append(errs, field.Duplicate(path.Child("codes").Index(i), code))
 		}
+		codes[code] = true
 	}
 
 	return errs
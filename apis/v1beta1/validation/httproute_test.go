@@ -567,7 +567,7 @@ func TestValidateHTTPRoute(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var errs field.ErrorList
 			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{Rules: tc.rules}}
-			errs = ValidateHTTPRoute(&route)
+			errs = ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -649,7 +649,7 @@ func TestValidateHTTPBackendUniqueFilters(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{Rules: tc.rules}}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -717,7 +717,7 @@ func TestValidateHTTPPathMatch(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -778,7 +778,7 @@ func TestValidateHTTPHeaderMatches(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(tc.expectErr) == 0 {
 				assert.Emptyf(t, errs, "expected no errors, got %d errors: %s", len(errs), errs)
 			} else {
@@ -842,7 +842,7 @@ func TestValidateHTTPQueryParamMatches(t *testing.T) {
 				}},
 			}}
 
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(tc.expectErr) == 0 {
 				assert.Emptyf(t, errs, "expected no errors, got %d errors: %s", len(errs), errs)
 			} else {
@@ -941,12 +941,93 @@ func TestValidateServicePort(t *testing.T) {
 				},
 			}},
 		}},
+	}, {
+		name:     "serviceimport with port",
+		errCount: 0,
+		rules: []gatewayv1b1.HTTPRouteRule{{
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Group: groupPtr("multicluster.x-k8s.io"),
+						Kind:  kindPtr("ServiceImport"),
+						Name:  "backend",
+						Port:  portPtr(99),
+					},
+				},
+			}},
+		}},
+	}, {
+		name:     "serviceimport with no port",
+		errCount: 1,
+		rules: []gatewayv1b1.HTTPRouteRule{{
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Group: groupPtr("multicluster.x-k8s.io"),
+						Kind:  kindPtr("ServiceImport"),
+						Name:  "backend",
+					},
+				},
+			}},
+		}},
+	}, {
+		name:     "serviceimport with core group",
+		errCount: 1,
+		rules: []gatewayv1b1.HTTPRouteRule{{
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Group: groupPtr(""),
+						Kind:  kindPtr("ServiceImport"),
+						Name:  "backend",
+						Port:  portPtr(99),
+					},
+				},
+			}},
+		}},
+	}, {
+		name:     "unsupported kind in multicluster group",
+		errCount: 1,
+		rules: []gatewayv1b1.HTTPRouteRule{{
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Group: groupPtr("multicluster.x-k8s.io"),
+						Kind:  kindPtr("ServiceExport"),
+						Name:  "backend",
+						Port:  portPtr(99),
+					},
+				},
+			}},
+		}},
+	}, {
+		name:     "missing ports across multiple rules",
+		errCount: 2,
+		rules: []gatewayv1b1.HTTPRouteRule{{
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Name: "backend",
+					},
+				},
+			}},
+		}, {
+			BackendRefs: []gatewayv1b1.HTTPBackendRef{{
+				BackendRef: gatewayv1b1.BackendRef{
+					BackendObjectReference: gatewayv1b1.BackendObjectReference{
+						Group: groupPtr("multicluster.x-k8s.io"),
+						Kind:  kindPtr("ServiceImport"),
+						Name:  "backend",
+					},
+				},
+			}},
+		}},
 	}}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{Rules: tc.rules}}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -1105,7 +1186,7 @@ func TestValidateHTTPRouteTypeMatchesField(t *testing.T) {
 					}},
 				},
 			}
-			errs := ValidateHTTPRoute(&route)
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
@@ -1169,10 +1250,77 @@ func TestValidateRequestRedirectFiltersWithNoBackendRef(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			var errs field.ErrorList
 			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{Rules: tc.rules}}
-			errs = ValidateHTTPRoute(&route)
+			errs = ValidateHTTPRoute(&route, ExperimentalChannel)
 			if len(errs) != tc.errCount {
 				t.Errorf("got %d errors, want %d errors: %s", len(errs), tc.errCount, errs)
 			}
 		})
 	}
 }
+
+func TestValidateHTTPRouteRetry(t *testing.T) {
+	tests := []struct {
+		name  string
+		retry *gatewayv1b1.HTTPRouteRetry
+		errs  []string
+	}{{
+		name: "valid retry",
+		retry: &gatewayv1b1.HTTPRouteRetry{
+			Attempts:      ptrTo(int32(3)),
+			PerTryTimeout: ptrTo(gatewayv1b1.Duration("1s500ms")),
+			Codes:         []gatewayv1b1.HTTPRouteRetryStatusCode{502, 503},
+			Backoff:       ptrTo(gatewayv1b1.Duration("100ms")),
+		},
+	}, {
+		name:  "retries disabled",
+		retry: &gatewayv1b1.HTTPRouteRetry{Attempts: ptrTo(int32(0))},
+	}, {
+		name:  "too many attempts",
+		retry: &gatewayv1b1.HTTPRouteRetry{Attempts: ptrTo(int32(17))},
+		errs:  []string{"spec.rules[0].retry.attempts: Invalid value: 17: must be between 0 and 16"},
+	}, {
+		name:  "negative attempts",
+		retry: &gatewayv1b1.HTTPRouteRetry{Attempts: ptrTo(int32(-1))},
+		errs:  []string{"spec.rules[0].retry.attempts: Invalid value: -1: must be between 0 and 16"},
+	}, {
+		name: "backoff with retries disabled",
+		retry: &gatewayv1b1.HTTPRouteRetry{
+			Attempts: ptrTo(int32(0)),
+			Backoff:  ptrTo(gatewayv1b1.Duration("1s")),
+		},
+		errs: []string{`spec.rules[0].retry.backoff: Invalid value: "1s": must not be set when attempts is 0`},
+	}, {
+		name:  "per try timeout not in GEP-2257 format",
+		retry: &gatewayv1b1.HTTPRouteRetry{PerTryTimeout: ptrTo(gatewayv1b1.Duration("1.5s"))},
+		errs:  []string{`spec.rules[0].retry.perTryTimeout: Invalid value: "1.5s": must be a GEP-2257 duration (matching ^([0-9]{1,5}(h|m|s|ms)){1,4}$)`},
+	}, {
+		name:  "backoff not in GEP-2257 format",
+		retry: &gatewayv1b1.HTTPRouteRetry{Backoff: ptrTo(gatewayv1b1.Duration("1d"))},
+		errs:  []string{`spec.rules[0].retry.backoff: Invalid value: "1d": must be a GEP-2257 duration (matching ^([0-9]{1,5}(h|m|s|ms)){1,4}$)`},
+	}, {
+		name:  "status code out of range",
+		retry: &gatewayv1b1.HTTPRouteRetry{Codes: []gatewayv1b1.HTTPRouteRetryStatusCode{503, 200}},
+		errs:  []string{"spec.rules[0].retry.codes[1]: Invalid value: 200: must be between 400 and 599"},
+	}, {
+		name:  "duplicate status code",
+		retry: &gatewayv1b1.HTTPRouteRetry{Codes: []gatewayv1b1.HTTPRouteRetryStatusCode{503, 503}},
+		errs:  []string{"spec.rules[0].retry.codes[1]: Duplicate value: 503"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := gatewayv1b1.HTTPRoute{Spec: gatewayv1b1.HTTPRouteSpec{
+				Rules: []gatewayv1b1.HTTPRouteRule{{Retry: tc.retry}},
+			}}
+			errs := ValidateHTTPRoute(&route, ExperimentalChannel)
+			if len(errs) != len(tc.errs) {
+				t.Fatalf("got %d errors, want %d errors: %s", len(errs), len(tc.errs), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.errs[i] {
+					t.Errorf("got error %q, want %q", err.Error(), tc.errs[i])
+				}
+			}
+		})
+	}
+}
@@ -58,6 +58,8 @@ func init() {
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&GRPCRoute{},
+		&GRPCRouteList{},
 		&Gateway{},
 		&GatewayClass{},
 		&GatewayClassList{},
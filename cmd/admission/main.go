@@ -78,6 +78,7 @@ func main() {
 	}
 	mux := http.NewServeMux()
 	mux.HandleFunc("/validate", admission.ServeHTTP)
+	mux.HandleFunc("/convert", admission.ServeConversion)
 	server.Handler = mux
 
 	var wg sync.WaitGroup
@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// celcost prints the estimated cost of every CEL validation rule of the
+// generated CRDs against the API server's limits, and fails if a rule or a
+// CRD exceeds the configured thresholds.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"sigs.k8s.io/gateway-api/pkg/celcost"
+	"sigs.k8s.io/gateway-api/pkg/crdcompat"
+)
+
+var (
+	crdDir        = flag.String("crd-dir", "config/crd", "Directory containing the CRDs")
+	ruleThreshold = flag.Uint64("rule-threshold", celcost.RuleCostLimit, "Exit with status 1 if the estimated cost of a rule exceeds this value")
+	crdThreshold  = flag.Uint64("crd-threshold", celcost.CRDCostLimit, "Exit with status 1 if the estimated total cost of the rules of a CRD version exceeds this value")
+	output        = flag.String("output", "text", "Output format, one of text or json")
+	verbose       = flag.Bool("v", false, "Print the cost of every rule, not only the total of every CRD version")
+)
+
+func main() {
+	flag.Parse()
+
+	failed, err := run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func run() (bool, error) {
+	bundle, err := crdcompat.LoadDir(*crdDir)
+	if err != nil {
+		return false, err
+	}
+
+	var costs []channelCost
+	for _, channel := range sortedKeys(bundle.CRDs) {
+		for _, name := range sortedKeys(bundle.CRDs[channel]) {
+			versions, err := celcost.Estimate(bundle.CRDs[channel][name])
+			if err != nil {
+				return false, fmt.Errorf("%s channel: %w", channel, err)
+			}
+			for _, version := range versions {
+				costs = append(costs, channelCost{Channel: channel, VersionCost: version})
+			}
+		}
+	}
+
+	var failures []string
+	for _, version := range costs {
+		if version.Total > *crdThreshold {
+			failures = append(failures, fmt.Sprintf("%s: total cost %s exceeds %d", version.name(), formatCost(version.Total), *crdThreshold))
+		}
+		for _, rule := range version.Rules {
+			if rule.Cost > *ruleThreshold {
+				failures = append(failures, fmt.Sprintf("%s: %s: cost %s of rule %q exceeds %d", version.name(), rule.Path, formatCost(rule.Cost), rule.Rule, *ruleThreshold))
+			}
+		}
+	}
+
+	switch *output {
+	case "text":
+		err = writeText(costs)
+	case "json":
+		var data []byte
+		data, err = json.MarshalIndent(costs, "", "  ")
+		if err == nil {
+			_, err = fmt.Println(string(data))
+		}
+	default:
+		return false, fmt.Errorf("unknown output format %q", *output)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, failure := range failures {
+		fmt.Fprintln(os.Stderr, failure)
+	}
+	return len(failures) > 0, nil
+}
+
+// channelCost is the estimated cost of a CRD version in a release channel.
+type channelCost struct {
+	Channel string `json:"channel"`
+	celcost.VersionCost
+}
+
+func (c channelCost) name() string {
+	return fmt.Sprintf("%s/%s (%s)", c.CRD, c.Version, c.Channel)
+}
+
+func writeText(costs []channelCost) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "CHANNEL\tCRD\tVERSION\tRULES\tCOST\t%% OF LIMIT\n")
+	for _, version := range costs {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n", version.Channel, version.CRD, version.Version, len(version.Rules), formatCost(version.Total), percentage(version.Total, celcost.CRDCostLimit))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if !*verbose {
+		return nil
+	}
+
+	for _, version := range costs {
+		if len(version.Rules) == 0 {
+			continue
+		}
+		fmt.Printf("\n%s:\n", version.name())
+		fmt.Fprintf(w, "PATH\tCOST\t%% OF LIMIT\tCARDINALITY\tRULE\n")
+		for _, rule := range version.Rules {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", rule.Path, formatCost(rule.Cost), percentage(rule.Cost, celcost.RuleCostLimit), formatCost(rule.Cardinality), rule.Rule)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatCost(cost uint64) string {
+	if cost == math.MaxUint64 {
+		return "unbounded"
+	}
+	return fmt.Sprint(cost)
+}
+
+func percentage(cost, limit uint64) string {
+	if cost == math.MaxUint64 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", float64(cost)*100/float64(limit))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
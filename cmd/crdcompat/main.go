@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// crdcompat compares the generated CRDs with those of a previous release and
+// classifies every change as compatible, needing a version bump, or
+// breaking.
+//
+// The previous CRDs are read from a directory (-old-dir), from a git ref
+// (-old-ref), or from the git tag of a previous bundle version
+// (-old-bundle-version).
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/pkg/crdcompat"
+)
+
+var (
+	oldDir           = flag.String("old-dir", "", "Directory containing the previous CRDs")
+	oldRef           = flag.String("old-ref", "", "Git ref to read the previous CRDs from")
+	oldBundleVersion = flag.String("old-bundle-version", "", "Bundle version to compare against; its CRDs are read from the git tag of the same name")
+	newDir           = flag.String("new-dir", "config/crd", "Directory containing the new CRDs")
+	crdPath          = flag.String("crd-path", "config/crd", "Path of the CRDs within the repository, used with -old-ref and -old-bundle-version")
+	output           = flag.String("output", "text", "Output format, one of text, json or yaml")
+	failOn           = flag.String("fail-on", string(crdcompat.Breaking), "Exit with status 1 if any change is at least this severe, one of Breaking, VersionBump or None")
+)
+
+func main() {
+	flag.Parse()
+
+	failed, err := run()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func run() (bool, error) {
+	oldBundle, err := loadOldBundle()
+	if err != nil {
+		return false, err
+	}
+	newBundle, err := crdcompat.LoadDir(*newDir)
+	if err != nil {
+		return false, fmt.Errorf("loading new CRDs: %w", err)
+	}
+
+	report := crdcompat.Compare(oldBundle, newBundle)
+	switch *output {
+	case "text":
+		err = report.WriteText(os.Stdout, crdcompat.VersionBump)
+	case "json":
+		var data []byte
+		data, err = json.MarshalIndent(report, "", "  ")
+		if err == nil {
+			_, err = fmt.Println(string(data))
+		}
+	case "yaml":
+		var data []byte
+		data, err = yaml.Marshal(report)
+		if err == nil {
+			_, err = os.Stdout.Write(data)
+		}
+	default:
+		return false, fmt.Errorf("unknown output format %q", *output)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	switch threshold := crdcompat.Classification(*failOn); threshold {
+	case "None":
+		return false, nil
+	case crdcompat.Breaking, crdcompat.VersionBump:
+		return report.Result.AtLeast(threshold), nil
+	default:
+		return false, fmt.Errorf("unknown -fail-on value %q", *failOn)
+	}
+}
+
+func loadOldBundle() (*crdcompat.Bundle, error) {
+	switch {
+	case *oldDir != "" && (*oldRef != "" || *oldBundleVersion != ""),
+		*oldRef != "" && *oldBundleVersion != "":
+		return nil, errors.New("only one of -old-dir, -old-ref and -old-bundle-version may be set")
+	case *oldDir != "":
+		return crdcompat.LoadDir(*oldDir)
+	case *oldRef != "":
+		return loadGitRef(*oldRef)
+	case *oldBundleVersion != "":
+		bundle, err := loadGitRef(*oldBundleVersion)
+		if err != nil {
+			return nil, err
+		}
+		if bundle.Version != *oldBundleVersion {
+			return nil, fmt.Errorf("CRDs at %s have bundle version %q", *oldBundleVersion, bundle.Version)
+		}
+		return bundle, nil
+	default:
+		return nil, errors.New("one of -old-dir, -old-ref or -old-bundle-version is required")
+	}
+}
+
+// loadGitRef returns a Bundle of the CRDs below crdPath at ref, without
+// touching the working tree.
+func loadGitRef(ref string) (*crdcompat.Bundle, error) {
+	out, err := exec.Command("git", "ls-tree", "-r", "--full-name", "--name-only", ref, "--", *crdPath).Output()
+	if err != nil {
+		return nil, fmt.Errorf("listing CRDs at %s: %w", ref, gitError(err))
+	}
+
+	bundle := crdcompat.NewBundle()
+	for _, path := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if !strings.HasSuffix(path, ".yaml") {
+			continue
+		}
+		data, err := exec.Command("git", "show", ref+":"+path).Output()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s at %s: %w", path, ref, gitError(err))
+		}
+		if err := bundle.Add(data); err != nil {
+			return nil, fmt.Errorf("%s at %s: %w", path, ref, err)
+		}
+	}
+	if len(bundle.CRDs) == 0 {
+		return nil, fmt.Errorf("no CRDs found below %s at %s", *crdPath, ref)
+	}
+	return bundle, nil
+}
+
+func gitError(err error) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+	}
+	return err
+}
@@ -34,12 +34,27 @@ type ConformanceReport struct {
 	Date string `json:"date"`
 
 	// GatewayAPIVersion indicates which release version of Gateway API this
-	// test report was made for.
+	// test report was made for, as recorded in the bundle version annotation
+	// of the installed CRDs.
 	GatewayAPIVersion string `json:"gatewayAPIVersion"`
 
+	// GatewayAPIChannel indicates which release channel of Gateway API, standard
+	// or experimental, this test report was made for, as recorded in the
+	// channel annotation of the installed CRDs.
+	GatewayAPIChannel string `json:"gatewayAPIChannel"`
+
 	// ProfileReports is a list of the individual reports for each conformance
 	// profile that was enabled for a test run.
 	ProfileReports []ProfileReport `json:"profiles"`
+
+	// TestMetrics includes timing measurements for each test that ran, sorted
+	// by test name.
+	TestMetrics []TestMetrics `json:"testMetrics,omitempty"`
+
+	// NonConformantTimeouts lists the timeouts of the test run that were
+	// higher than allowed to claim conformance. A report that lists any of
+	// them can't be used as a claim of conformance.
+	NonConformantTimeouts []NonConformantTimeout `json:"nonConformantTimeouts,omitempty"`
 }
 
 // Implementation provides metadata information on the downstream
@@ -0,0 +1,49 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestMetrics includes timing measurements for a single conformance test, so
+// that implementations can track how quickly they propagate configuration to
+// the data plane across releases.
+type TestMetrics struct {
+	// Name is the short name of the test.
+	Name string `json:"name"`
+
+	// WallTime is how long the test took to run, from applying its manifests
+	// until all of its assertions completed, not including cleanup.
+	WallTime metav1.Duration `json:"wallTime"`
+
+	// Convergences is the number of times the test waited for the data plane
+	// to consistently give the expected responses.
+	Convergences uint32 `json:"convergences,omitempty"`
+
+	// Attempts is the number of requests the test made while waiting for the
+	// data plane, in all convergences.
+	Attempts uint32 `json:"attempts,omitempty"`
+
+	// TimeToFirstSuccess is the longest time, in all convergences, until the
+	// data plane gave a first expected response.
+	TimeToFirstSuccess *metav1.Duration `json:"timeToFirstSuccess,omitempty"`
+
+	// TimeToConsistency is the longest time, in all convergences, until the
+	// data plane consistently gave the expected responses.
+	TimeToConsistency *metav1.Duration `json:"timeToConsistency,omitempty"`
+}
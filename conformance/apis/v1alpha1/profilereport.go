@@ -70,4 +70,9 @@ type Status struct {
 	// FailedTests indicates which tests were failing during the execution of
 	// test suite.
 	FailedTests []string `json:"failedTests,omitempty"`
+
+	// NotSelectedTests indicates which tests were not run because they were
+	// excluded by the tests selected for the run, e.g. to debug specific
+	// tests. Like skipped tests, they identify the results as being partial.
+	NotSelectedTests []string `json:"notSelectedTests,omitempty"`
 }
@@ -29,7 +29,8 @@ var (
 	Success Result = "success"
 
 	// Partial indicates that the test run concluded in some of the required
-	// tests passing without any failures, but some were skipped.
+	// tests passing without any failures, but some were skipped or not
+	// selected to run.
 	Partial Result = "partial"
 
 	// Failure indicates that the test run concluded in one ore more tests
@@ -30,6 +30,11 @@ type Statistics struct {
 	// the test suite.
 	Skipped uint32
 
+	// NotSelected indicates how many tests were not run because they were
+	// excluded by the tests selected for the run, rather than because of
+	// their features.
+	NotSelected uint32
+
 	// Failed indicates how many tests were unsuccessful.
 	Failed uint32
 }
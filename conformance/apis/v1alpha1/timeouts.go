@@ -0,0 +1,35 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NonConformantTimeout is a timeout that a test run used with a value higher
+// than the maximum allowed to claim conformance.
+type NonConformantTimeout struct {
+	// Name is the name of the timeout, e.g. MaxTimeToConsistency.
+	Name string `json:"name"`
+
+	// Value is the value of the timeout in the test run.
+	Value metav1.Duration `json:"value"`
+
+	// Max is the highest value of the timeout that a conformant test run can
+	// use.
+	Max metav1.Duration `json:"max"`
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conformance_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance"
+	"sigs.k8s.io/gateway-api/conformance/utils/benchmark"
+	"sigs.k8s.io/gateway-api/conformance/utils/flags"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+func TestPropagationBenchmark(t *testing.T) {
+	routeCounts, err := benchmark.ParseRouteCounts(*flags.BenchmarkRouteCounts)
+	if err != nil {
+		t.Fatalf("Error parsing benchmark route counts: %v", err)
+	}
+	if len(routeCounts) == 0 {
+		t.Skip("Skipping the propagation benchmark, set -benchmark-route-counts to run it")
+	}
+	timeoutConfig, err := suite.ParseTimeoutConfig(*flags.TimeoutConfig, flags.TimeoutOverrides)
+	if err != nil {
+		t.Fatalf("Error parsing timeout config: %v", err)
+	}
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		t.Fatalf("Error loading Kubernetes config: %v", err)
+	}
+	client, err := client.New(cfg, client.Options{})
+	if err != nil {
+		t.Fatalf("Error initializing Kubernetes client: %v", err)
+	}
+	gatewayClient, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		t.Fatalf("Error initializing Gateway API client: %v", err)
+	}
+
+	v1beta1.AddToScheme(client.Scheme())
+
+	report := benchmark.Run(t, benchmark.Options{
+		Client:        client,
+		GatewayClient: gatewayClient,
+		RoundTripper:  &roundtripper.DefaultRoundTripper{Debug: *flags.ShowDebug, TimeoutConfig: timeoutConfig},
+		Applier: kubernetes.Applier{
+			FS:              conformance.Manifests,
+			GatewayClass:    *flags.GatewayClassName,
+			NamespaceLabels: suite.ParseNamespaceLabels(*flags.NamespaceLabels),
+			NamespacePrefix: *flags.NamespacePrefix,
+		},
+		TimeoutConfig: timeoutConfig,
+		RouteCounts:   routeCounts,
+		Samples:       *flags.BenchmarkSamples,
+		PollInterval:  *flags.BenchmarkPollInterval,
+	})
+
+	if *flags.BenchmarkReport != "" {
+		if err := benchmark.WriteReport(*flags.BenchmarkReport, report); err != nil {
+			t.Fatalf("Error writing benchmark report: %v", err)
+		}
+		return
+	}
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		t.Fatalf("Error marshaling benchmark report: %v", err)
+	}
+	t.Logf("Propagation benchmark report:\n%s", out)
+}
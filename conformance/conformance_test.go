@@ -18,6 +18,7 @@ limitations under the License.
 package conformance_test
 
 import (
+	"os"
 	"testing"
 
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
@@ -33,6 +34,50 @@ import (
 )
 
 func TestConformance(t *testing.T) {
+	supportedFeatures := suite.ParseSupportedFeatures(*flags.SupportedFeatures)
+	exemptFeatures := suite.ParseSupportedFeatures(*flags.ExemptFeatures)
+	skipTests := suite.ParseSkipTests(*flags.SkipTests)
+	runTests := suite.ParseRunTests(*flags.RunTests)
+	namespaceLabels := suite.ParseNamespaceLabels(*flags.NamespaceLabels)
+	namespacePrefix, err := suite.ParseNamespacePrefix(*flags.NamespacePrefix)
+	if err != nil {
+		t.Fatalf("Error parsing namespace prefix: %v", err)
+	}
+	testPattern, err := suite.ParseTestPattern(*flags.TestPattern)
+	if err != nil {
+		t.Fatalf("Error parsing test pattern: %v", err)
+	}
+	timeoutConfig, err := suite.ParseTimeoutConfig(*flags.TimeoutConfig, flags.TimeoutOverrides)
+	if err != nil {
+		t.Fatalf("Error parsing timeout config: %v", err)
+	}
+
+	options := suite.Options{
+		GatewayClassName:           *flags.GatewayClassName,
+		Debug:                      *flags.ShowDebug,
+		CleanupBaseResources:       *flags.CleanupBaseResources,
+		SupportedFeatures:          supportedFeatures,
+		ExemptFeatures:             exemptFeatures,
+		EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
+		NamespaceLabels:            namespaceLabels,
+		NamespacePrefix:            namespacePrefix,
+		SkipTests:                  skipTests,
+		RunTests:                   runTests,
+		TestPattern:                testPattern,
+		ResultsDir:                 *flags.ResultsDir,
+		ArtifactsDir:               *flags.ArtifactsDir,
+		ParallelTests:              *flags.ParallelTests,
+		TimeoutConfig:              timeoutConfig,
+	}
+
+	// Listing the tests does not need a cluster.
+	if *flags.ListTests {
+		if err := suite.New(options).ListTests(os.Stdout, tests.ConformanceTests); err != nil {
+			t.Fatalf("Error listing tests: %v", err)
+		}
+		return
+	}
+
 	cfg, err := config.GetConfig()
 	if err != nil {
 		t.Fatalf("Error loading Kubernetes config: %v", err)
@@ -49,29 +94,16 @@ func TestConformance(t *testing.T) {
 	v1alpha2.AddToScheme(client.Scheme())
 	v1beta1.AddToScheme(client.Scheme())
 
-	supportedFeatures := suite.ParseSupportedFeatures(*flags.SupportedFeatures)
-	exemptFeatures := suite.ParseSupportedFeatures(*flags.ExemptFeatures)
-	skipTests := suite.ParseSkipTests(*flags.SkipTests)
-	namespaceLabels := suite.ParseNamespaceLabels(*flags.NamespaceLabels)
+	options.Client = client
+	options.RestConfig = cfg
+	// This clientset is needed in addition to the client only because
+	// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
+	options.Clientset = clientset
 
 	t.Logf("Running conformance tests with %s GatewayClass\n cleanup: %t\n debug: %t\n enable all features: %t \n supported features: [%v]\n exempt features: [%v]",
 		*flags.GatewayClassName, *flags.CleanupBaseResources, *flags.ShowDebug, *flags.EnableAllSupportedFeatures, *flags.SupportedFeatures, *flags.ExemptFeatures)
 
-	cSuite := suite.New(suite.Options{
-		Client:     client,
-		RestConfig: cfg,
-		// This clientset is needed in addition to the client only because
-		// controller-runtime client doesn't support non CRUD sub-resources yet (https://github.com/kubernetes-sigs/controller-runtime/issues/452).
-		Clientset:                  clientset,
-		GatewayClassName:           *flags.GatewayClassName,
-		Debug:                      *flags.ShowDebug,
-		CleanupBaseResources:       *flags.CleanupBaseResources,
-		SupportedFeatures:          supportedFeatures,
-		ExemptFeatures:             exemptFeatures,
-		EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
-		NamespaceLabels:            namespaceLabels,
-		SkipTests:                  skipTests,
-	})
+	cSuite := suite.New(options)
 	cSuite.Setup(t)
 
 	cSuite.Run(t, tests.ConformanceTests)
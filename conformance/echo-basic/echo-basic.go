@@ -0,0 +1,256 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// echo-basic is a minimal echo server used as a conformance backend. It
+// responds to every request with a JSON description of the request it
+// received, in the same format as the ingress-controller-conformance
+// echoserver. Unlike that server, it accepts HTTP/2 over cleartext (h2c) and
+// WebSocket upgrades on the same port as HTTP/1.1, so that tests can observe
+// which protocol a Gateway used to reach the backend. gRPC calls to any
+// service and method are echoed back on the same port too.
+//
+// For Layer 4 routes, every line received on TCP_PORT (default 3100) and every
+// datagram received on UDP_PORT (default 3200) is answered with a JSON
+// description of the payload and of the backend that received it.
+//
+// Requests can also ask the server to fail, so that tests can observe
+// retries: a request with an X-Echo-Fail-Key header is answered with the
+// status code from X-Echo-Fail-Status (default 503) for the first
+// X-Echo-Fail-Times requests carrying the same key. Every such response
+// carries an X-Echo-Attempt header with the number of requests seen for the
+// key so far.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"golang.org/x/net/websocket"
+)
+
+// RequestAssertions contains information about the request and the Ingress.
+type RequestAssertions struct {
+	Path    string              `json:"path"`
+	Host    string              `json:"host"`
+	Method  string              `json:"method"`
+	Proto   string              `json:"proto"`
+	Headers map[string][]string `json:"headers"`
+
+	Context `json:",inline"`
+}
+
+// Context contains information about the context where the echoserver is
+// running.
+type Context struct {
+	Namespace string `json:"namespace"`
+	Ingress   string `json:"ingress"`
+	Service   string `json:"service"`
+	Pod       string `json:"pod"`
+}
+
+var echoContext Context
+
+func main() {
+	httpPort := os.Getenv("HTTP_PORT")
+	if httpPort == "" {
+		httpPort = "3000"
+	}
+	tcpPort := os.Getenv("TCP_PORT")
+	if tcpPort == "" {
+		tcpPort = "3100"
+	}
+	udpPort := os.Getenv("UDP_PORT")
+	if udpPort == "" {
+		udpPort = "3200"
+	}
+
+	echoContext = Context{
+		Namespace: os.Getenv("NAMESPACE"),
+		Ingress:   os.Getenv("INGRESS_NAME"),
+		Service:   os.Getenv("SERVICE_NAME"),
+		Pod:       os.Getenv("POD_NAME"),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/health", healthHandler)
+	mux.HandleFunc("/", echoHandler)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%s", httpPort),
+		Handler:           h2c.NewHandler(mux, &http2.Server{}),
+		ReadHeaderTimeout: time.Second,
+	}
+
+	tcpListener, err := net.Listen("tcp", fmt.Sprintf(":%s", tcpPort))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to listen on TCP port %s: %v\n", tcpPort, err)
+		os.Exit(1)
+	}
+	go serveTCP(tcpListener)
+
+	udpConn, err := net.ListenPacket("udp", fmt.Sprintf(":%s", udpPort))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to listen on UDP port %s: %v\n", udpPort, err)
+		os.Exit(1)
+	}
+	go serveUDP(udpConn)
+
+	fmt.Printf("Starting server, listening on port %s (http, h2c, websocket, grpc), %s (tcp) and %s (udp)\n", httpPort, tcpPort, udpPort)
+	if err := server.ListenAndServe(); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to start server: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func healthHandler(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(`OK`))
+}
+
+func echoHandler(w http.ResponseWriter, r *http.Request) {
+	if isGRPCRequest(r) {
+		grpcServer.ServeHTTP(w, r)
+		return
+	}
+
+	fmt.Printf("Echoing back request made to %s to client (%s)\n", r.RequestURI, r.RemoteAddr)
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		websocketServer.ServeHTTP(w, r)
+		return
+	}
+
+	if failStatus, fail := injectFailure(w, r); fail {
+		processError(w, fmt.Errorf("injected failure for %s", r.Header.Get("X-Echo-Fail-Key")), failStatus)
+		return
+	}
+
+	js, err := requestAssertions(r)
+	if err != nil {
+		processError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeEchoResponseHeaders(w, r.Header)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	_, _ = w.Write(js)
+}
+
+// websocketServer accepts WebSocket upgrades from any origin. The first
+// message sent on the connection is the JSON description of the upgrade
+// request, after which every received message is echoed back.
+var websocketServer = websocket.Server{
+	Handshake: func(*websocket.Config, *http.Request) error { return nil },
+	Handler: func(ws *websocket.Conn) {
+		defer ws.Close()
+
+		js, err := requestAssertions(ws.Request())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to encode websocket request: %v\n", err)
+			return
+		}
+		if err := websocket.Message.Send(ws, string(js)); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to send websocket message: %v\n", err)
+			return
+		}
+		_, _ = io.Copy(ws, ws)
+	},
+}
+
+func requestAssertions(r *http.Request) ([]byte, error) {
+	return json.MarshalIndent(RequestAssertions{
+		Path:    r.RequestURI,
+		Host:    r.Host,
+		Method:  r.Method,
+		Proto:   r.Proto,
+		Headers: r.Header,
+
+		Context: echoContext,
+	}, "", " ")
+}
+
+// writeEchoResponseHeaders sets the response headers requested by the client
+// with the X-Echo-Set-Header header, formatted as a comma-separated list of
+// "name:value" pairs.
+func writeEchoResponseHeaders(w http.ResponseWriter, headers http.Header) {
+	for _, headerKVList := range headers["X-Echo-Set-Header"] {
+		for _, headerKV := range strings.Split(headerKVList, ",") {
+			name, value, found := strings.Cut(strings.TrimSpace(headerKV), ":")
+			if !found {
+				continue
+			}
+			w.Header().Add(name, value)
+		}
+	}
+}
+
+// failureAttempts counts the requests seen for each X-Echo-Fail-Key.
+var failureAttempts = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: map[string]int{}}
+
+// injectFailure records an attempt for requests with an X-Echo-Fail-Key
+// header and reports whether the request should fail, and with which status
+// code.
+func injectFailure(w http.ResponseWriter, r *http.Request) (int, bool) {
+	key := r.Header.Get("X-Echo-Fail-Key")
+	if key == "" {
+		return 0, false
+	}
+
+	failureAttempts.Lock()
+	failureAttempts.counts[key]++
+	attempt := failureAttempts.counts[key]
+	failureAttempts.Unlock()
+	w.Header().Set("X-Echo-Attempt", strconv.Itoa(attempt))
+
+	times, err := strconv.Atoi(r.Header.Get("X-Echo-Fail-Times"))
+	if err != nil || attempt > times {
+		return 0, false
+	}
+
+	status, err := strconv.Atoi(r.Header.Get("X-Echo-Fail-Status"))
+	if err != nil || status < 100 || status > 599 {
+		status = http.StatusServiceUnavailable
+	}
+	return status, true
+}
+
+func processError(w http.ResponseWriter, err error, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+
+	body, _ := json.Marshal(struct {
+		Message string `json:"message"`
+	}{
+		err.Error(),
+	})
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// grpcServer answers unary calls to any service and method with a
+// google.protobuf.Struct describing the call it received: the fully qualified
+// method, the request metadata and the context of the server. The response
+// header metadata is set from the X-Echo-Set-Header request metadata, like
+// for HTTP requests.
+var grpcServer = grpc.NewServer(grpc.UnknownServiceHandler(grpcEchoHandler))
+
+// isGRPCRequest reports whether r is a gRPC call, which are only made over
+// HTTP/2.
+func isGRPCRequest(r *http.Request) bool {
+	return r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc")
+}
+
+func grpcEchoHandler(_ interface{}, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	fmt.Printf("Echoing back gRPC call to %s to client\n", method)
+
+	if err := stream.RecvMsg(&structpb.Struct{}); err != nil {
+		return err
+	}
+
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	headers := map[string]interface{}{}
+	for name, values := range md {
+		list := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			list = append(list, value)
+		}
+		headers[name] = list
+	}
+
+	responseMD := metadata.MD{}
+	for _, headerKVList := range md.Get("X-Echo-Set-Header") {
+		for _, headerKV := range strings.Split(headerKVList, ",") {
+			name, value, found := strings.Cut(strings.TrimSpace(headerKV), ":")
+			if !found {
+				continue
+			}
+			responseMD.Append(name, value)
+		}
+	}
+	if err := stream.SetHeader(responseMD); err != nil {
+		return err
+	}
+
+	response, err := structpb.NewStruct(map[string]interface{}{
+		"fullyQualifiedMethod": method,
+		"headers":              headers,
+		"namespace":            echoContext.Namespace,
+		"ingress":              echoContext.Ingress,
+		"service":              echoContext.Service,
+		"pod":                  echoContext.Pod,
+	})
+	if err != nil {
+		return err
+	}
+	return stream.SendMsg(response)
+}
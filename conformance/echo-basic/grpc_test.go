@@ -0,0 +1,75 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc/codes"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+)
+
+func TestGRPCEcho(t *testing.T) {
+	echoContext = Context{Namespace: "gateway-conformance-infra", Pod: "grpc-infra-backend-v1-abc"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", echoHandler)
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	defer server.Close()
+
+	rt := &grpc.DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	expected := grpc.ExpectedResponse{
+		Request: grpc.Request{
+			Host:    "grpc.example.com",
+			Service: grpc.EchoService,
+			Method:  "Echo",
+			Headers: map[string]string{"X-Test": "value"},
+		},
+		ExpectedRequest: &grpc.ExpectedRequest{
+			Request: grpc.Request{
+				Service: grpc.EchoService,
+				Method:  "Echo",
+				Headers: map[string]string{"x-test": "value", ":authority": "grpc.example.com"},
+			},
+		},
+		Response: grpc.Response{
+			Headers: map[string]string{"x-backend": "v1"},
+		},
+		Backend:   "grpc-infra-backend-v1",
+		Namespace: "gateway-conformance-infra",
+	}
+
+	req := expected.Request
+	req.Address = strings.TrimPrefix(server.URL, "http://")
+	req.Headers = map[string]string{"X-Test": "value", "X-Echo-Set-Header": "X-Backend:v1"}
+	cReq, cRes, err := rt.CaptureRoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, codes.OK, cRes.Code)
+	assert.NoError(t, grpc.CompareRequest(cReq, cRes, expected))
+
+	expected.Backend = "grpc-infra-backend-v2"
+	assert.Error(t, grpc.CompareRequest(cReq, cRes, expected))
+}
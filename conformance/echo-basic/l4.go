@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+)
+
+// maxDatagramSize is the largest UDP payload echo-basic reads.
+const maxDatagramSize = 65507
+
+// L4Assertions contains the payload received over a TCP or UDP connection,
+// together with the context of the backend that received it.
+type L4Assertions struct {
+	Protocol string `json:"protocol"`
+	Payload  string `json:"payload"`
+
+	Context `json:",inline"`
+}
+
+// serveTCP echoes every newline-terminated line received on a TCP
+// connection back as a single line of JSON, until the listener is closed.
+func serveTCP(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to accept TCP connection: %v\n", err)
+			continue
+		}
+		go handleTCPConn(conn)
+	}
+}
+
+func handleTCPConn(conn net.Conn) {
+	defer conn.Close()
+
+	fmt.Printf("Echoing back TCP stream to client (%s)\n", conn.RemoteAddr())
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		js, err := l4Assertions("TCP", scanner.Text())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to encode TCP payload: %v\n", err)
+			return
+		}
+		if _, err := conn.Write(append(js, '\n')); err != nil {
+			return
+		}
+	}
+}
+
+// serveUDP answers every datagram with a datagram carrying its JSON
+// description, until the connection is closed.
+func serveUDP(conn net.PacketConn) {
+	buf := make([]byte, maxDatagramSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if errors.Is(err, net.ErrClosed) {
+			return
+		} else if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to read UDP datagram: %v\n", err)
+			continue
+		}
+		fmt.Printf("Echoing back UDP datagram to client (%s)\n", addr)
+		js, err := l4Assertions("UDP", string(buf[:n]))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to encode UDP payload: %v\n", err)
+			continue
+		}
+		if _, err := conn.WriteTo(js, addr); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to write UDP datagram: %v\n", err)
+		}
+	}
+}
+
+func l4Assertions(protocol, payload string) ([]byte, error) {
+	return json.Marshal(L4Assertions{
+		Protocol: protocol,
+		Payload:  payload,
+
+		Context: echoContext,
+	})
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+)
+
+func TestL4Echo(t *testing.T) {
+	echoContext = Context{Namespace: "gateway-conformance-infra", Pod: "l4-infra-backend-v1-abc"}
+
+	tcpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer tcpListener.Close()
+	go serveTCP(tcpListener)
+
+	udpConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer udpConn.Close()
+	go serveUDP(udpConn)
+
+	rt := &l4.DefaultRoundTripper{TimeoutConfig: config.TimeoutConfig{RequestTimeout: 5 * time.Second}}
+	testCases := []struct {
+		protocol l4.Protocol
+		address  string
+	}{
+		{protocol: l4.ProtocolTCP, address: tcpListener.Addr().String()},
+		{protocol: l4.ProtocolUDP, address: udpConn.LocalAddr().String()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.protocol), func(t *testing.T) {
+			expected := l4.ExpectedResponse{
+				Request:   l4.Request{Protocol: tc.protocol, Payload: "hello"},
+				Backend:   "l4-infra-backend-v1",
+				Namespace: "gateway-conformance-infra",
+			}
+
+			req := expected.Request
+			req.Address = tc.address
+			cRes, err := rt.CaptureRoundTrip(req)
+			require.NoError(t, err)
+			assert.NoError(t, l4.CompareResponse(cRes, expected))
+
+			expected.Backend = "l4-infra-backend-v2"
+			assert.Error(t, l4.CompareResponse(cRes, expected))
+		})
+	}
+}
@@ -18,5 +18,5 @@ package conformance
 
 import "embed"
 
-//go:embed tests/* base/* mesh/*
+//go:embed tests/* base/* mesh/* benchmark/*
 var Manifests embed.FS
@@ -21,6 +21,7 @@ package conformance_test
 
 import (
 	"os"
+	"regexp"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -34,6 +35,7 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
 	"sigs.k8s.io/gateway-api/conformance/tests"
+	confconfig "sigs.k8s.io/gateway-api/conformance/utils/config"
 	"sigs.k8s.io/gateway-api/conformance/utils/flags"
 	"sigs.k8s.io/gateway-api/conformance/utils/suite"
 )
@@ -45,49 +47,74 @@ var (
 	supportedFeatures   sets.Set[suite.SupportedFeature]
 	exemptFeatures      sets.Set[suite.SupportedFeature]
 	namespaceLabels     map[string]string
+	namespacePrefix     string
 	implementation      *confv1a1.Implementation
 	conformanceProfiles sets.Set[suite.ConformanceProfileName]
 	skipTests           []string
+	timeoutConfig       confconfig.TimeoutConfig
+	runTests            []string
+	testPattern         *regexp.Regexp
 )
 
 func TestExperimentalConformance(t *testing.T) {
 	var err error
-	cfg, err = config.GetConfig()
-	if err != nil {
-		t.Fatalf("Error loading Kubernetes config: %v", err)
-	}
-	mgrClient, err = client.New(cfg, client.Options{})
-	if err != nil {
-		t.Fatalf("Error initializing Kubernetes client: %v", err)
-	}
-	k8sClientset, err = kubernetes.NewForConfig(cfg)
-	if err != nil {
-		t.Fatalf("Error initializing Kubernetes REST client: %v", err)
-	}
+	// Listing the tests does not need a cluster.
+	if !*flags.ListTests {
+		cfg, err = config.GetConfig()
+		if err != nil {
+			t.Fatalf("Error loading Kubernetes config: %v", err)
+		}
+		mgrClient, err = client.New(cfg, client.Options{})
+		if err != nil {
+			t.Fatalf("Error initializing Kubernetes client: %v", err)
+		}
+		k8sClientset, err = kubernetes.NewForConfig(cfg)
+		if err != nil {
+			t.Fatalf("Error initializing Kubernetes REST client: %v", err)
+		}
 
-	v1alpha2.AddToScheme(mgrClient.Scheme())
-	v1beta1.AddToScheme(mgrClient.Scheme())
+		v1alpha2.AddToScheme(mgrClient.Scheme())
+		v1beta1.AddToScheme(mgrClient.Scheme())
+	}
 
 	// standard conformance flags
 	supportedFeatures = suite.ParseSupportedFeatures(*flags.SupportedFeatures)
 	exemptFeatures = suite.ParseSupportedFeatures(*flags.ExemptFeatures)
 	skipTests = suite.ParseSkipTests(*flags.SkipTests)
+	runTests = suite.ParseRunTests(*flags.RunTests)
 	namespaceLabels = suite.ParseNamespaceLabels(*flags.NamespaceLabels)
+	namespacePrefix, err = suite.ParseNamespacePrefix(*flags.NamespacePrefix)
+	if err != nil {
+		t.Fatalf("Error parsing namespace prefix: %v", err)
+	}
+	testPattern, err = suite.ParseTestPattern(*flags.TestPattern)
+	if err != nil {
+		t.Fatalf("Error parsing test pattern: %v", err)
+	}
+	timeoutConfig, err = suite.ParseTimeoutConfig(*flags.TimeoutConfig, flags.TimeoutOverrides)
+	if err != nil {
+		t.Fatalf("Error parsing timeout config: %v", err)
+	}
 
 	// experimental conformance flags
 	conformanceProfiles = suite.ParseConformanceProfiles(*flags.ConformanceProfiles)
 
 	if conformanceProfiles.Len() > 0 {
 		// if some conformance profiles have been set, run the experimental conformance suite...
-		implementation, err = suite.ParseImplementation(
-			*flags.ImplementationOrganization,
-			*flags.ImplementationProject,
-			*flags.ImplementationUrl,
-			*flags.ImplementationVersion,
-			*flags.ImplementationContact,
-		)
-		if err != nil {
-			t.Fatalf("Error parsing implementation's details: %v", err)
+		if *flags.ListTests {
+			// Listing the tests does not report on the implementation.
+			implementation = &confv1a1.Implementation{}
+		} else {
+			implementation, err = suite.ParseImplementation(
+				*flags.ImplementationOrganization,
+				*flags.ImplementationProject,
+				*flags.ImplementationUrl,
+				*flags.ImplementationVersion,
+				*flags.ImplementationContact,
+			)
+			if err != nil {
+				t.Fatalf("Error parsing implementation's details: %v", err)
+			}
 		}
 		testExperimentalConformance(t)
 	} else {
@@ -115,7 +142,14 @@ func testExperimentalConformance(t *testing.T) {
 				ExemptFeatures:             exemptFeatures,
 				EnableAllSupportedFeatures: *flags.EnableAllSupportedFeatures,
 				NamespaceLabels:            namespaceLabels,
+				NamespacePrefix:            namespacePrefix,
 				SkipTests:                  skipTests,
+				RunTests:                   runTests,
+				TestPattern:                testPattern,
+				ResultsDir:                 *flags.ResultsDir,
+				ArtifactsDir:               *flags.ArtifactsDir,
+				ParallelTests:              *flags.ParallelTests,
+				TimeoutConfig:              timeoutConfig,
 			},
 			Implementation:      *implementation,
 			ConformanceProfiles: conformanceProfiles,
@@ -124,6 +158,13 @@ func testExperimentalConformance(t *testing.T) {
 		t.Fatalf("error creating experimental conformance test suite: %v", err)
 	}
 
+	if *flags.ListTests {
+		if err := cSuite.ListTests(os.Stdout, tests.ConformanceTests); err != nil {
+			t.Fatalf("error listing tests: %v", err)
+		}
+		return
+	}
+
 	cSuite.Setup(t)
 	cSuite.Run(t, tests.ConformanceTests)
 	report, err := cSuite.Report()
@@ -39,8 +39,8 @@ var GatewayInvalidRouteKind = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/gateway-invalid-route-kind.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
-		t.Run("Gateway listener should have a false ResolvedRefs condition with reason InvalidRouteKinds and no supportedKinds", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-only-invalid-route-kind", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "Gateway listener should have a false ResolvedRefs condition with reason InvalidRouteKinds and no supportedKinds", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-only-invalid-route-kind", Namespace: s.InfraNamespace()}
 			listeners := []v1beta1.ListenerStatus{{
 				Name:           v1beta1.SectionName("http"),
 				SupportedKinds: []v1beta1.RouteGroupKind{},
@@ -55,8 +55,8 @@ var GatewayInvalidRouteKind = suite.ConformanceTest{
 			kubernetes.GatewayStatusMustHaveListeners(t, s.Client, s.TimeoutConfig, gwNN, listeners)
 		})
 
-		t.Run("Gateway listener should have a false ResolvedRefs condition with reason InvalidRouteKinds and HTTPRoute must be put in the supportedKinds", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-supported-and-invalid-route-kind", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "Gateway listener should have a false ResolvedRefs condition with reason InvalidRouteKinds and HTTPRoute must be put in the supportedKinds", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-supported-and-invalid-route-kind", Namespace: s.InfraNamespace()}
 			listeners := []v1beta1.ListenerStatus{{
 				Name: v1beta1.SectionName("http"),
 				SupportedKinds: []v1beta1.RouteGroupKind{{
@@ -59,25 +59,25 @@ var GatewayInvalidTLSConfiguration = suite.ConformanceTest{
 		}{
 			{
 				name:                  "Nonexistent secret referenced as CertificateRef in a Gateway listener",
-				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-nonexistent-secret", Namespace: "gateway-conformance-infra"},
+				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-nonexistent-secret", Namespace: s.InfraNamespace()},
 			},
 			{
 				name:                  "Unsupported group resource referenced as CertificateRef in a Gateway listener",
-				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-unsupported-group", Namespace: "gateway-conformance-infra"},
+				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-unsupported-group", Namespace: s.InfraNamespace()},
 			},
 			{
 				name:                  "Unsupported kind resource referenced as CertificateRef in a Gateway listener",
-				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-unsupported-kind", Namespace: "gateway-conformance-infra"},
+				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-unsupported-kind", Namespace: s.InfraNamespace()},
 			},
 			{
 				name:                  "Malformed secret referenced as CertificateRef in a Gateway listener",
-				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-malformed-secret", Namespace: "gateway-conformance-infra"},
+				gatewayNamespacedName: types.NamespacedName{Name: "gateway-certificate-malformed-secret", Namespace: s.InfraNamespace()},
 			},
 		}
 
 		for _, tc := range testCases {
 			tc := tc
-			t.Run(tc.name, func(t *testing.T) {
+			s.RunSubtest(t, tc.name, func(t *testing.T) {
 				t.Parallel()
 				kubernetes.GatewayStatusMustHaveListeners(t, s.Client, s.TimeoutConfig, tc.gatewayNamespacedName, listeners)
 			})
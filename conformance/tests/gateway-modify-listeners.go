@@ -43,12 +43,12 @@ var GatewayModifyListeners = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/gateway-modify-listeners.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
-		t.Run("should be able to add a listener that then becomes available for routing traffic", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-add-listener", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "should be able to add a listener that then becomes available for routing traffic", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-add-listener", Namespace: s.InfraNamespace()}
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 			defer cancel()
 
-			namespaces := []string{"gateway-conformance-infra"}
+			namespaces := []string{s.InfraNamespace()}
 			kubernetes.NamespacesMustBeReady(t, s.Client, s.TimeoutConfig, namespaces)
 
 			// verify that the implementation is tracking the most recent resource changes
@@ -121,12 +121,12 @@ var GatewayModifyListeners = suite.ConformanceTest{
 			require.NotEqual(t, original.Generation, updated.Generation, "generation should change after an update")
 		})
 
-		t.Run("should be able to remove listeners, which would then stop routing the relevant traffic", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-remove-listener", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "should be able to remove listeners, which would then stop routing the relevant traffic", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-remove-listener", Namespace: s.InfraNamespace()}
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 			defer cancel()
 
-			namespaces := []string{"gateway-conformance-infra"}
+			namespaces := []string{s.InfraNamespace()}
 			kubernetes.NamespacesMustBeReady(t, s.Client, s.TimeoutConfig, namespaces)
 
 			// verify that the implementation is tracking the most recent resource changes
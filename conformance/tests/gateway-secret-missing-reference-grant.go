@@ -40,9 +40,9 @@ var GatewaySecretMissingReferenceGrant = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/gateway-secret-missing-reference-grant.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
-		gwNN := types.NamespacedName{Name: "gateway-secret-missing-reference-grant", Namespace: "gateway-conformance-infra"}
+		gwNN := types.NamespacedName{Name: "gateway-secret-missing-reference-grant", Namespace: s.InfraNamespace()}
 
-		t.Run("Gateway listener should have a false ResolvedRefs condition with reason RefNotPermitted", func(t *testing.T) {
+		s.RunSubtest(t, "Gateway listener should have a false ResolvedRefs condition with reason RefNotPermitted", func(t *testing.T) {
 			listeners := []v1beta1.ListenerStatus{{
 				Name: v1beta1.SectionName("https"),
 				SupportedKinds: []v1beta1.RouteGroupKind{{
@@ -39,8 +39,8 @@ var GatewayWithAttachedRoutes = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/gateway-with-attached-routes.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
-		t.Run("Gateway listener should have one valid http routes attached", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-with-one-attached-route", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "Gateway listener should have one valid http routes attached", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-with-one-attached-route", Namespace: s.InfraNamespace()}
 			listeners := []v1beta1.ListenerStatus{{
 				Name: v1beta1.SectionName("http"),
 				SupportedKinds: []v1beta1.RouteGroupKind{{
@@ -58,8 +58,8 @@ var GatewayWithAttachedRoutes = suite.ConformanceTest{
 			kubernetes.GatewayStatusMustHaveListeners(t, s.Client, s.TimeoutConfig, gwNN, listeners)
 		})
 
-		t.Run("Gateway listener should have two valid http routes attached", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-with-two-attached-routes", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "Gateway listener should have two valid http routes attached", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-with-two-attached-routes", Namespace: s.InfraNamespace()}
 			listeners := []v1beta1.ListenerStatus{{
 				Name: v1beta1.SectionName("http"),
 				SupportedKinds: []v1beta1.RouteGroupKind{{
@@ -77,8 +77,8 @@ var GatewayWithAttachedRoutes = suite.ConformanceTest{
 			kubernetes.GatewayStatusMustHaveListeners(t, s.Client, s.TimeoutConfig, gwNN, listeners)
 		})
 
-		t.Run("Gateway listener should have attached route by specifying the sectionName", func(t *testing.T) {
-			gwNN := types.NamespacedName{Name: "gateway-with-two-listeners-and-one-attached-route", Namespace: "gateway-conformance-infra"}
+		s.RunSubtest(t, "Gateway listener should have attached route by specifying the sectionName", func(t *testing.T) {
+			gwNN := types.NamespacedName{Name: "gateway-with-two-listeners-and-one-attached-route", Namespace: s.InfraNamespace()}
 			listeners := []v1beta1.ListenerStatus{
 				{
 					Name: v1beta1.SectionName("http-unattached"),
@@ -44,7 +44,7 @@ var GatewayClassObservedGenerationBump = suite.ConformanceTest{
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
 		gwc := types.NamespacedName{Name: "gatewayclass-observed-generation-bump"}
 
-		t.Run("observedGeneration should increment", func(t *testing.T) {
+		s.RunSubtest(t, "observedGeneration should increment", func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 			defer cancel()
 
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteCrossNamespace)
+}
+
+var GRPCRouteCrossNamespace = suite.ConformanceTest{
+	ShortName:   "GRPCRouteCrossNamespace",
+	Description: "A GRPCRoute in the gateway-conformance-infra namespace can reference a BackendRef Service in the gateway-conformance-web-backend namespace that a ReferenceGrant allows",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+		suite.SupportReferenceGrant,
+	},
+	Manifests: []string{"tests/grpcroute-cross-namespace.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		routeNN := types.NamespacedName{Name: "cross-namespace", Namespace: suite.InfraNamespace()}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: suite.InfraNamespace()}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1beta1.GRPCRoute{}, routeNN)
+		kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1beta1.GRPCRoute{}, routeNN, gwNN)
+
+		suite.RunSubtest(t, "Simple gRPC call should reach grpc-web-backend", func(t *testing.T) {
+			grpc.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, grpc.ExpectedResponse{
+				Request:   grpc.Request{Service: grpc.EchoService, Method: "Echo"},
+				Backend:   "grpc-web-backend",
+				Namespace: suite.WebBackendNamespace(),
+			})
+		})
+	},
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteInvalidBackendRef)
+}
+
+var GRPCRouteInvalidBackendRef = suite.ConformanceTest{
+	ShortName:   "GRPCRouteInvalidBackendRef",
+	Description: "GRPCRoutes in the gateway-conformance-infra namespace are accepted but set a ResolvedRefs status False when their BackendRef Service does not exist, or is in another namespace and no ReferenceGrant allows it",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+		suite.SupportReferenceGrant,
+	},
+	Manifests: []string{"tests/grpcroute-invalid-backendref.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		nonexistentNN := types.NamespacedName{Name: "invalid-backendref-nonexistent", Namespace: ns}
+		crossNamespaceNN := types.NamespacedName{Name: "invalid-cross-namespace-backend-ref", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+
+		// The Routes must be Attached.
+		kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1beta1.GRPCRoute{}, nonexistentNN, crossNamespaceNN)
+
+		suite.RunSubtest(t, "GRPCRoute with a nonexistent BackendRef has a ResolvedRefs Condition with status False and Reason BackendNotFound", func(t *testing.T) {
+			kubernetes.RouteMustHaveCondition(t, suite.Client, suite.TimeoutConfig, &v1beta1.GRPCRoute{}, nonexistentNN, gwNN, metav1.Condition{
+				Type:   string(v1beta1.RouteConditionResolvedRefs),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.RouteReasonBackendNotFound),
+			})
+		})
+
+		suite.RunSubtest(t, "GRPCRoute with a cross-namespace BackendRef and no ReferenceGrant has a ResolvedRefs Condition with status False and Reason RefNotPermitted", func(t *testing.T) {
+			kubernetes.RouteMustHaveCondition(t, suite.Client, suite.TimeoutConfig, &v1beta1.GRPCRoute{}, crossNamespaceNN, gwNN, metav1.Condition{
+				Type:   string(v1beta1.RouteConditionResolvedRefs),
+				Status: metav1.ConditionFalse,
+				Reason: string(v1beta1.RouteReasonRefNotPermitted),
+			})
+		})
+	},
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteRegexMethodMatching)
+}
+
+var GRPCRouteRegexMethodMatching = suite.ConformanceTest{
+	ShortName:   "GRPCRouteRegexMethodMatching",
+	Description: "A single GRPCRoute with regular expression service and method matching for different backends",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+		suite.SupportGRPCRouteMethodRegexMatching,
+	},
+	Manifests: []string{"tests/grpcroute-regex-method-matching.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "regex-method-matching", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1beta1.GRPCRoute{}, routeNN)
+		kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1beta1.GRPCRoute{}, routeNN, gwNN)
+
+		testCases := []grpc.ExpectedResponse{{
+			Request:   grpc.Request{Service: grpc.EchoService, Method: "EchoTwo"},
+			Backend:   "grpc-infra-backend-v2",
+			Namespace: ns,
+		}, {
+			Request:   grpc.Request{Service: grpc.EchoService, Method: "EchoThree"},
+			Backend:   "grpc-infra-backend-v2",
+			Namespace: ns,
+		}, {
+			Request:   grpc.Request{Service: "gateway_api_conformance.echo_basic.grpcecho.OtherEcho", Method: "Echo"},
+			Backend:   "grpc-infra-backend-v3",
+			Namespace: ns,
+		}, {
+			Request:   grpc.Request{Service: "gateway_api_conformance.echo_basic.grpcecho.OtherService", Method: "Echo"},
+			Backend:   "grpc-infra-backend-v3",
+			Namespace: ns,
+		}, {
+			Request:  grpc.Request{Service: grpc.EchoService, Method: "Echo"},
+			Response: grpc.Response{Code: codes.Unimplemented},
+		}, {
+			Request:  grpc.Request{Service: "gateway_api_conformance.echo_basic.grpcecho.OtherEcho", Method: "EchoTwo"},
+			Response: grpc.Response{Code: codes.Unimplemented},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				grpc.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
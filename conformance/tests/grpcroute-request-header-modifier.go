@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, GRPCRouteRequestHeaderModifier)
+}
+
+var GRPCRouteRequestHeaderModifier = suite.ConformanceTest{
+	ShortName:   "GRPCRouteRequestHeaderModifier",
+	Description: "A GRPCRoute has request header modifier filters applied correctly",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportGRPCRoute,
+		suite.SupportGRPCRouteRequestHeaderModification,
+	},
+	Manifests: []string{"tests/grpcroute-request-header-modifier.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "request-header-modifier", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1beta1.GRPCRoute{}, routeNN)
+		kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1beta1.GRPCRoute{}, routeNN, gwNN)
+
+		echo := grpc.Request{Service: grpc.EchoService, Method: "Echo"}
+		testCases := []grpc.ExpectedResponse{{
+			TestCaseName: "headers are set, added and removed",
+			Request: grpc.Request{
+				Service: echo.Service,
+				Method:  echo.Method,
+				Headers: map[string]string{
+					"Some-Other-Header": "val",
+					"X-Header-Set":      "some-other-value",
+					"X-Header-Add":      "some-value",
+					"X-Header-Remove":   "val",
+				},
+			},
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Service: echo.Service,
+					Method:  echo.Method,
+					Headers: map[string]string{
+						"Some-Other-Header": "val",
+						"X-Header-Set":      "set-overwrites-values",
+						"X-Header-Add":      "some-value,add-appends-values",
+					},
+				},
+				AbsentHeaders: []string{"X-Header-Remove"},
+			},
+			Backend:   "grpc-infra-backend-v1",
+			Namespace: ns,
+		}, {
+			TestCaseName: "headers are set and added when absent",
+			Request:      echo,
+			ExpectedRequest: &grpc.ExpectedRequest{
+				Request: grpc.Request{
+					Service: echo.Service,
+					Method:  echo.Method,
+					Headers: map[string]string{
+						"X-Header-Set": "set-overwrites-values",
+						"X-Header-Add": "add-appends-values",
+					},
+				},
+			},
+			Backend:   "grpc-infra-backend-v1",
+			Namespace: ns,
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
+				t.Parallel()
+				grpc.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.GRPCRoundTripper, suite.TimeoutConfig, gwAddr, tc)
+			})
+		}
+	},
+}
@@ -39,17 +39,17 @@ var HTTPRouteCrossNamespace = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-cross-namespace.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		routeNN := types.NamespacedName{Name: "cross-namespace", Namespace: "gateway-conformance-web-backend"}
-		gwNN := types.NamespacedName{Name: "backend-namespaces", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "cross-namespace", Namespace: suite.WebBackendNamespace()}
+		gwNN := types.NamespacedName{Name: "backend-namespaces", Namespace: suite.InfraNamespace()}
 		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
-		t.Run("Simple HTTP request should reach web-backend", func(t *testing.T) {
+		suite.RunSubtest(t, "Simple HTTP request should reach web-backend", func(t *testing.T) {
 			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
 				Request:   http.Request{Path: "/"},
 				Response:  http.Response{StatusCode: 200},
 				Backend:   "web-backend",
-				Namespace: "gateway-conformance-web-backend",
+				Namespace: suite.WebBackendNamespace(),
 			})
 		})
 	},
@@ -43,23 +43,23 @@ var HTTPRouteDisallowedKind = suite.ConformanceTest{
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
 		// This test creates an additional Gateway in the gateway-conformance-infra
 		// namespace so we have to wait for it to be ready.
-		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{"gateway-conformance-infra"})
+		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{suite.InfraNamespace()})
 
-		routeNN := types.NamespacedName{Name: "disallowed-kind", Namespace: "gateway-conformance-infra"}
-		gwNN := types.NamespacedName{Name: "tlsroutes-only", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "disallowed-kind", Namespace: suite.InfraNamespace()}
+		gwNN := types.NamespacedName{Name: "tlsroutes-only", Namespace: suite.InfraNamespace()}
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
-		t.Run("Route should not have been accepted with reason NotAllowedByListeners", func(t *testing.T) {
+		suite.RunSubtest(t, "Route should not have been accepted with reason NotAllowedByListeners", func(t *testing.T) {
 			kubernetes.HTTPRouteMustHaveCondition(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN, metav1.Condition{
 				Type:   string(v1beta1.RouteConditionAccepted),
 				Status: metav1.ConditionFalse,
 				Reason: string(v1beta1.RouteReasonNotAllowedByListeners),
 			})
 		})
-		t.Run("Route should not have Parents set in status", func(t *testing.T) {
+		suite.RunSubtest(t, "Route should not have Parents set in status", func(t *testing.T) {
 			kubernetes.HTTPRouteMustHaveNoAcceptedParents(t, suite.Client, suite.TimeoutConfig, routeNN)
 		})
-		t.Run("Gateway should have 0 Routes attached", func(t *testing.T) {
+		suite.RunSubtest(t, "Gateway should have 0 Routes attached", func(t *testing.T) {
 			kubernetes.GatewayMustHaveZeroRoutes(t, suite.Client, suite.TimeoutConfig, gwNN)
 		})
 	},
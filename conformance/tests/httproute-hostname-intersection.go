@@ -41,14 +41,14 @@ var HTTPRouteHostnameIntersection = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-hostname-intersection.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.InfraNamespace()
 		gwNN := types.NamespacedName{Name: "httproute-hostname-intersection", Namespace: ns}
 
 		// This test creates an additional Gateway in the gateway-conformance-infra
 		// namespace so we have to wait for it to be ready.
 		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, []string{ns})
 
-		t.Run("HTTPRoutes that do intersect with listener hostnames", func(t *testing.T) {
+		suite.RunSubtest(t, "HTTPRoutes that do intersect with listener hostnames", func(t *testing.T) {
 			routes := []types.NamespacedName{
 				{Namespace: ns, Name: "specific-host-matches-listener-specific-host"},
 				{Namespace: ns, Name: "specific-host-matches-listener-wildcard-host"},
@@ -195,14 +195,14 @@ var HTTPRouteHostnameIntersection = suite.ConformanceTest{
 				// Declare tc here to avoid loop variable
 				// reuse issues across parallel tests.
 				tc := testCases[i]
-				t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 					t.Parallel()
 					http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
 				})
 			}
 		})
 
-		t.Run("HTTPRoutes that do not intersect with listener hostnames", func(t *testing.T) {
+		suite.RunSubtest(t, "HTTPRoutes that do not intersect with listener hostnames", func(t *testing.T) {
 			gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN))
 			routeNN := types.NamespacedName{Namespace: ns, Name: "no-intersecting-hosts"}
 
@@ -235,7 +235,7 @@ var HTTPRouteHostnameIntersection = suite.ConformanceTest{
 				// Declare tc here to avoid loop variable
 				// reuse issues across parallel tests.
 				tc := testCases[i]
-				t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+				suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 					t.Parallel()
 					http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
 				})
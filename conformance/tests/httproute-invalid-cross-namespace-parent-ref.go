@@ -40,15 +40,15 @@ var HTTPRouteInvalidCrossNamespaceParentRef = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-invalid-cross-namespace-parent-ref.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: "gateway-conformance-infra"}
-		routeNN := types.NamespacedName{Name: "invalid-cross-namespace-parent-ref", Namespace: "gateway-conformance-web-backend"}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: suite.InfraNamespace()}
+		routeNN := types.NamespacedName{Name: "invalid-cross-namespace-parent-ref", Namespace: suite.WebBackendNamespace()}
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
 		// When running conformance tests, implementations are expected to have visibility across all namespaces, and
 		// must be setting this condition on routes that are not allowed. However, outside of conformance testing,
 		// it's also valid for implementations to run in modes where they only have access to a limited subset of
 		// namespaces, in which case they are not obligated to populate this condition on routes they cannot access.
-		t.Run("HTTPRoute should have an Accepted: false condition with reason NotAllowedByListeners", func(t *testing.T) {
+		suite.RunSubtest(t, "HTTPRoute should have an Accepted: false condition with reason NotAllowedByListeners", func(t *testing.T) {
 			acceptedCond := metav1.Condition{
 				Type:   string(v1beta1.RouteConditionAccepted),
 				Status: metav1.ConditionFalse,
@@ -58,11 +58,11 @@ var HTTPRouteInvalidCrossNamespaceParentRef = suite.ConformanceTest{
 			kubernetes.HTTPRouteMustHaveCondition(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN, acceptedCond)
 		})
 
-		t.Run("Route should not have Parents set in status", func(t *testing.T) {
+		suite.RunSubtest(t, "Route should not have Parents set in status", func(t *testing.T) {
 			kubernetes.HTTPRouteMustHaveNoAcceptedParents(t, suite.Client, suite.TimeoutConfig, routeNN)
 		})
 
-		t.Run("Gateway should have 0 Routes attached", func(t *testing.T) {
+		suite.RunSubtest(t, "Gateway should have 0 Routes attached", func(t *testing.T) {
 			kubernetes.GatewayMustHaveZeroRoutes(t, suite.Client, suite.TimeoutConfig, gwNN)
 		})
 	},
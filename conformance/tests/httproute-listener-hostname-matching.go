@@ -39,7 +39,7 @@ var HTTPRouteListenerHostnameMatching = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-listener-hostname-matching.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.InfraNamespace()
 
 		// This test creates an additional Gateway in the gateway-conformance-infra
 		// namespace so we have to wait for it to be ready.
@@ -95,7 +95,7 @@ var HTTPRouteListenerHostnameMatching = suite.ConformanceTest{
 			// Declare tc here to avoid loop variable
 			// reuse issues across parallel tests.
 			tc := testCases[i]
-			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+			suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 				t.Parallel()
 				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
 			})
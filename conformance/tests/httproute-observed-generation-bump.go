@@ -44,14 +44,14 @@ var HTTPRouteObservedGenerationBump = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-observed-generation-bump.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		routeNN := types.NamespacedName{Name: "observed-generation-bump", Namespace: "gateway-conformance-infra"}
-		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "observed-generation-bump", Namespace: suite.InfraNamespace()}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: suite.InfraNamespace()}
 
-		t.Run("observedGeneration should increment", func(t *testing.T) {
+		suite.RunSubtest(t, "observedGeneration should increment", func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 			defer cancel()
 
-			namespaces := []string{"gateway-conformance-infra"}
+			namespaces := []string{suite.InfraNamespace()}
 			kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
 
 			original := &v1beta1.HTTPRoute{}
@@ -39,10 +39,11 @@ var HTTPRouteQueryParamMatching = suite.ConformanceTest{
 		suite.SupportHTTPRoute,
 		suite.SupportHTTPRouteQueryParamMatching,
 	},
+	Parallel: true,
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.InfraNamespace()
 		routeNN := types.NamespacedName{Namespace: ns, Name: "query-param-matching"}
-		gwNN := types.NamespacedName{Namespace: ns, Name: "same-namespace"}
+		gwNN := types.NamespacedName{Namespace: ns, Name: suite.GatewayName("same-namespace")}
 		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
@@ -148,7 +149,7 @@ var HTTPRouteQueryParamMatching = suite.ConformanceTest{
 
 		for i := range testCases {
 			tc := testCases[i]
-			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+			suite.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 				t.Parallel()
 				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, tc)
 			})
@@ -43,7 +43,7 @@ var HTTPRouteRedirectPortAndScheme = suite.ConformanceTest{
 		suite.SupportGatewayPort8080,
 	},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.InfraNamespace()
 
 		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
 		routeNN := types.NamespacedName{Name: "http-route-for-listener-on-port-80", Namespace: ns}
@@ -154,7 +154,7 @@ var HTTPRouteRedirectPortAndScheme = suite.ConformanceTest{
 
 		for i := range testCases {
 			tc := testCases[i]
-			t.Run("http-listener-on-80/"+tc.GetTestCaseName(i), func(t *testing.T) {
+			suite.RunSubtest(t, "http-listener-on-80/"+tc.GetTestCaseName(i), func(t *testing.T) {
 				t.Parallel()
 				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr80, tc)
 			})
@@ -206,7 +206,7 @@ var HTTPRouteRedirectPortAndScheme = suite.ConformanceTest{
 
 		for i := range testCases {
 			tc := testCases[i]
-			t.Run("http-listener-on-8080/"+tc.GetTestCaseName(i), func(t *testing.T) {
+			suite.RunSubtest(t, "http-listener-on-8080/"+tc.GetTestCaseName(i), func(t *testing.T) {
 				t.Parallel()
 				http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr8080, tc)
 			})
@@ -301,7 +301,7 @@ var HTTPRouteRedirectPortAndScheme = suite.ConformanceTest{
 
 		for i := range testCases {
 			tc := testCases[i]
-			t.Run("https-listener-on-443/"+tc.GetTestCaseName(i), func(t *testing.T) {
+			suite.RunSubtest(t, "https-listener-on-443/"+tc.GetTestCaseName(i), func(t *testing.T) {
 				t.Parallel()
 				tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr443, cPem, keyPem, "example.org", tc)
 			})
@@ -44,12 +44,12 @@ var HTTPRouteReferenceGrant = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/httproute-reference-grant.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		routeNN := types.NamespacedName{Name: "reference-grant", Namespace: "gateway-conformance-infra"}
-		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "reference-grant", Namespace: suite.InfraNamespace()}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: suite.InfraNamespace()}
 		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
-		t.Run("Simple HTTP request should reach web-backend", func(t *testing.T) {
+		suite.RunSubtest(t, "Simple HTTP request should reach web-backend", func(t *testing.T) {
 			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
 				Request: http.Request{
 					Method: "GET",
@@ -57,7 +57,7 @@ var HTTPRouteReferenceGrant = suite.ConformanceTest{
 				},
 				Response:  http.Response{StatusCode: 200},
 				Backend:   "web-backend",
-				Namespace: "gateway-conformance-web-backend",
+				Namespace: suite.WebBackendNamespace(),
 			})
 		})
 
@@ -66,12 +66,12 @@ var HTTPRouteReferenceGrant = suite.ConformanceTest{
 		rg := v1beta1.ReferenceGrant{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "reference-grant",
-				Namespace: "gateway-conformance-web-backend",
+				Namespace: suite.WebBackendNamespace(),
 			},
 		}
 		require.NoError(t, suite.Client.Delete(ctx, &rg))
 
-		t.Run("Simple HTTP request should return 500 after deleting the relevant reference grant", func(t *testing.T) {
+		suite.RunSubtest(t, "Simple HTTP request should return 500 after deleting the relevant reference grant", func(t *testing.T) {
 			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
 				Request: http.Request{
 					Method: "GET",
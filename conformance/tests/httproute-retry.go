@@ -0,0 +1,115 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteRetry)
+}
+
+var HTTPRouteRetry = suite.ConformanceTest{
+	ShortName:   "HTTPRouteRetry",
+	Description: "An HTTPRoute with a retry policy retries backend requests failing with a retryable status code, up to the configured number of attempts",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportHTTPRoute,
+		suite.SupportHTTPRouteRetry,
+	},
+	Manifests: []string{"tests/httproute-retry.yaml"},
+	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
+		ns := s.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "retry", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+
+		kubernetes.NamespacesMustBeReady(t, s.Client, s.TimeoutConfig, []string{ns})
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, s.Client, s.TimeoutConfig, s.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, s.Client, s.TimeoutConfig, routeNN, gwNN)
+
+		http.MakeRequestAndExpectEventuallyConsistentResponse(t, s.RoundTripper, s.TimeoutConfig, gwAddr, http.ExpectedResponse{
+			Request:   http.Request{Path: "/"},
+			Response:  http.Response{StatusCode: 200},
+			Backend:   "infra-backend-retry",
+			Namespace: ns,
+		})
+
+		// expectAttempts asks the backend to fail the first failTimes attempts
+		// of a request with failStatus, and expects the Gateway to respond
+		// with wantStatus after the backend has seen wantAttempts attempts.
+		// Every request uses a new failure key, as the backend counts
+		// attempts per key.
+		expectAttempts := func(t *testing.T, failTimes, failStatus, wantStatus, wantAttempts int) {
+			t.Helper()
+
+			http.AwaitConvergence(t, s.TimeoutConfig.RequiredConsecutiveSuccesses, s.TimeoutConfig.MaxTimeToConsistency, func(elapsed time.Duration) bool {
+				expected := http.ExpectedResponse{
+					Request: http.Request{
+						Path: "/",
+						Headers: map[string]string{
+							"X-Echo-Fail-Key":    fmt.Sprintf("%s-%d", strings.ReplaceAll(t.Name(), "/", "-"), time.Now().UnixNano()),
+							"X-Echo-Fail-Times":  strconv.Itoa(failTimes),
+							"X-Echo-Fail-Status": strconv.Itoa(failStatus),
+						},
+					},
+					Response:  http.Response{StatusCode: wantStatus},
+					Backend:   "infra-backend-retry",
+					Namespace: ns,
+				}
+				req := http.MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+
+				cReq, cRes, err := s.RoundTripper.CaptureRoundTrip(req)
+				if err != nil {
+					t.Logf("Request failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+					return false
+				}
+				if err := http.CompareRequest(t, &req, cReq, cRes, expected); err != nil {
+					t.Logf("Response expectation failed for request: %+v  not ready yet: %v (after %v)", req, err, elapsed)
+					return false
+				}
+				if got := strings.Join(cRes.Headers["X-Echo-Attempt"], ","); got != strconv.Itoa(wantAttempts) {
+					t.Logf("Expected the backend to see %d attempts, got %q (after %v)", wantAttempts, got, elapsed)
+					return false
+				}
+				return true
+			})
+		}
+
+		s.RunSubtest(t, "request failing with a retryable status code is retried until it succeeds", func(t *testing.T) {
+			expectAttempts(t, 2, 503, 200, 3)
+		})
+
+		s.RunSubtest(t, "request failing with a retryable status code is not retried beyond the configured attempts", func(t *testing.T) {
+			expectAttempts(t, 5, 503, 503, 3)
+		})
+
+		s.RunSubtest(t, "request failing with a non-retryable status code is not retried", func(t *testing.T) {
+			expectAttempts(t, 1, 500, 500, 1)
+		})
+	},
+}
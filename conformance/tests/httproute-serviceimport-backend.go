@@ -0,0 +1,82 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, HTTPRouteServiceImportBackend)
+}
+
+var HTTPRouteServiceImportBackend = suite.ConformanceTest{
+	ShortName:   "HTTPRouteServiceImportBackend",
+	Description: "A single HTTPRoute in the gateway-conformance-infra namespace with a BackendRef to a multi-cluster ServiceImport should forward traffic to the endpoints of the exported Service it imports",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportHTTPRoute,
+		suite.SupportHTTPRouteServiceImportBackend,
+	},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "serviceimport-backend", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+
+		// The ServiceExport and ServiceImport CRDs are only installed when
+		// ServiceImport is not already present, so that an existing
+		// Multi-Cluster Services installation is left untouched.
+		crdName := "serviceimports.multicluster.x-k8s.io"
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+		ctx, cancel := context.WithTimeout(context.Background(), suite.TimeoutConfig.GetTimeout)
+		defer cancel()
+		err := suite.Client.Get(ctx, types.NamespacedName{Name: crdName}, crd)
+		if apierrors.IsNotFound(err) {
+			suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, "tests/httproute-serviceimport-backend-crd.yaml", true)
+		} else {
+			require.NoErrorf(t, err, "error getting CustomResourceDefinition %s", crdName)
+		}
+		kubernetes.CustomResourceDefinitionMustBeEstablished(t, suite.Client, suite.TimeoutConfig, "serviceexports.multicluster.x-k8s.io")
+		kubernetes.CustomResourceDefinitionMustBeEstablished(t, suite.Client, suite.TimeoutConfig, crdName)
+
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, "tests/httproute-serviceimport-backend.yaml", true)
+
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
+
+		suite.RunSubtest(t, "HTTP request should reach the backend imported by the ServiceImport", func(t *testing.T) {
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
+				Request:   http.Request{Path: "/"},
+				Response:  http.Response{StatusCode: 200},
+				Backend:   "infra-backend-v1",
+				Namespace: ns,
+			})
+		})
+	},
+}
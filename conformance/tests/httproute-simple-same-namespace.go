@@ -39,19 +39,20 @@ var HTTPRouteSimpleSameNamespace = suite.ConformanceTest{
 		suite.SupportHTTPRoute,
 	},
 	Manifests: []string{"tests/httproute-simple-same-namespace.yaml"},
+	Parallel:  true,
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := v1beta1.Namespace("gateway-conformance-infra")
+		ns := v1beta1.Namespace(suite.InfraNamespace())
 		routeNN := types.NamespacedName{Name: "gateway-conformance-infra-test", Namespace: string(ns)}
-		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: string(ns)}
+		gwNN := types.NamespacedName{Name: suite.GatewayName("same-namespace"), Namespace: string(ns)}
 		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
 		kubernetes.HTTPRouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, routeNN, gwNN)
 
-		t.Run("Simple HTTP request should reach infra-backend", func(t *testing.T) {
+		suite.RunSubtest(t, "Simple HTTP request should reach infra-backend", func(t *testing.T) {
 			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, http.ExpectedResponse{
 				Request:   http.Request{Path: "/"},
 				Response:  http.Response{StatusCode: 200},
 				Backend:   "infra-backend-v1",
-				Namespace: "gateway-conformance-infra",
+				Namespace: string(ns),
 			})
 		})
 	},
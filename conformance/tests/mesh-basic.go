@@ -50,7 +50,7 @@ var MeshBasic = suite.ConformanceTest{
 			// Declare tc here to avoid loop variable
 			// reuse issues across parallel tests.
 			tc := cases[i]
-			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+			s.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 				client.MakeRequestAndExpectEventuallyConsistentResponse(t, tc, s.TimeoutConfig)
 			})
 		}
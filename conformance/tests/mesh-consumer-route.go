@@ -38,12 +38,12 @@ var MeshConsumerRoute = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/mesh-consumer-route.yaml"},
 	Test: func(t *testing.T, s *suite.ConformanceTestSuite) {
-		consumerClient := echo.ConnectToAppInNamespace(t, s, echo.MeshAppEchoV1, "gateway-conformance-mesh-consumer")
+		consumerClient := echo.ConnectToAppInNamespace(t, s, echo.MeshAppEchoV1, s.MeshConsumerNamespace())
 		consumerCases := []http.ExpectedResponse{
 			{
 				TestCaseName: "request from consumer route's namespace modified by HTTPRoute",
 				Request: http.Request{
-					Host:   "echo-v1.gateway-conformance-mesh",
+					Host:   "echo-v1." + s.MeshNamespace(),
 					Method: "GET",
 					Path:   "/",
 				},
@@ -56,12 +56,12 @@ var MeshConsumerRoute = suite.ConformanceTest{
 				Backend: "echo-v1",
 			},
 		}
-		producerClient := echo.ConnectToAppInNamespace(t, s, echo.MeshAppEchoV1, "gateway-conformance-mesh")
+		producerClient := echo.ConnectToAppInNamespace(t, s, echo.MeshAppEchoV1, s.MeshNamespace())
 		producerCases := []http.ExpectedResponse{
 			{
 				TestCaseName: "request not from consumer route's namespace not modified by HTTPRoute",
 				Request: http.Request{
-					Host:   "echo-v1.gateway-conformance-mesh",
+					Host:   "echo-v1." + s.MeshNamespace(),
 					Method: "GET",
 					Path:   "/",
 				},
@@ -73,12 +73,12 @@ var MeshConsumerRoute = suite.ConformanceTest{
 			},
 		}
 		for i, tc := range consumerCases {
-			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+			s.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 				consumerClient.MakeRequestAndExpectEventuallyConsistentResponse(t, tc, s.TimeoutConfig)
 			})
 		}
 		for i, tc := range producerCases {
-			t.Run(tc.GetTestCaseName(i), func(t *testing.T) {
+			s.RunSubtest(t, tc.GetTestCaseName(i), func(t *testing.T) {
 				producerClient.MakeRequestAndExpectEventuallyConsistentResponse(t, tc, s.TimeoutConfig)
 			})
 		}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"net"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteDestinationPortMatching)
+}
+
+var TCPRouteDestinationPortMatching = suite.ConformanceTest{
+	ShortName:   "TCPRouteDestinationPortMatching",
+	Description: "TCPRoutes attach to Gateway listeners by destination port and forward traffic for that port only to their own backend",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportTCPRoute,
+		suite.SupportRouteDestinationPortMatching,
+	},
+	Manifests: []string{"tests/tcproute-destination-port-matching.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		gwNN := types.NamespacedName{Name: "gateway-tcproute-ports", Namespace: ns}
+		routeNNs := []types.NamespacedName{
+			{Name: "port-9000", Namespace: ns},
+			{Name: "port-9001", Namespace: ns},
+		}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1alpha2.TCPRoute{}, routeNNs...)
+		for _, routeNN := range routeNNs {
+			kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1alpha2.TCPRoute{}, routeNN, gwNN)
+		}
+
+		host, _, err := net.SplitHostPort(gwAddr)
+		if err != nil {
+			t.Fatalf("unexpected Gateway address %s: %v", gwAddr, err)
+		}
+
+		testCases := []struct {
+			port     string
+			expected l4.ExpectedResponse
+		}{{
+			port: "9000",
+			expected: l4.ExpectedResponse{
+				Request:   l4.Request{Protocol: l4.ProtocolTCP, Payload: "port-9000"},
+				Backend:   "l4-infra-backend-v1",
+				Namespace: ns,
+			},
+		}, {
+			port: "9001",
+			expected: l4.ExpectedResponse{
+				Request:   l4.Request{Protocol: l4.ProtocolTCP, Payload: "port-9001"},
+				Backend:   "l4-infra-backend-v2",
+				Namespace: ns,
+			},
+		}}
+
+		for i := range testCases {
+			// Declare tc here to avoid loop variable
+			// reuse issues across parallel tests.
+			tc := testCases[i]
+			suite.RunSubtest(t, "TCP payload to port "+tc.port+" should reach "+tc.expected.Backend, func(t *testing.T) {
+				t.Parallel()
+				l4.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.L4RoundTripper, suite.TimeoutConfig, net.JoinHostPort(host, tc.port), tc.expected)
+			})
+		}
+	},
+}
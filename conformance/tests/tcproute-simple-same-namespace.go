@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, TCPRouteSimpleSameNamespace)
+}
+
+var TCPRouteSimpleSameNamespace = suite.ConformanceTest{
+	ShortName:   "TCPRouteSimpleSameNamespace",
+	Description: "A single TCPRoute in the gateway-conformance-infra namespace attaches to a Gateway in the same namespace",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportTCPRoute,
+	},
+	Manifests: []string{"tests/tcproute-simple-same-namespace.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "tcp-route", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-tcproute", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1alpha2.TCPRoute{}, routeNN)
+		kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1alpha2.TCPRoute{}, routeNN, gwNN)
+
+		suite.RunSubtest(t, "Simple TCP payload should reach l4-infra-backend-v1", func(t *testing.T) {
+			l4.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.L4RoundTripper, suite.TimeoutConfig, gwAddr, l4.ExpectedResponse{
+				Request:   l4.Request{Protocol: l4.ProtocolTCP, Payload: "gateway-conformance"},
+				Backend:   "l4-infra-backend-v1",
+				Namespace: ns,
+			})
+		})
+	},
+}
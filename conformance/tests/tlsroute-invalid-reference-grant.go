@@ -41,12 +41,12 @@ var TLSRouteInvalidReferenceGrant = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/tlsroute-invalid-reference-grant.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		routeNN := types.NamespacedName{Name: "gateway-conformance-infra-test", Namespace: "gateway-conformance-infra"}
-		gwNN := types.NamespacedName{Name: "gateway-tlsroute-referencegrant", Namespace: "gateway-conformance-infra"}
+		routeNN := types.NamespacedName{Name: "gateway-conformance-infra-test", Namespace: suite.InfraNamespace()}
+		gwNN := types.NamespacedName{Name: "gateway-tlsroute-referencegrant", Namespace: suite.InfraNamespace()}
 
 		kubernetes.GatewayAndTLSRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
 
-		t.Run("TLSRoute with BackendRef in another namespace and no ReferenceGrant covering the Service has a ResolvedRefs Condition with status False and Reason RefNotPermitted", func(t *testing.T) {
+		suite.RunSubtest(t, "TLSRoute with BackendRef in another namespace and no ReferenceGrant covering the Service has a ResolvedRefs Condition with status False and Reason RefNotPermitted", func(t *testing.T) {
 			resolvedRefsCond := metav1.Condition{
 				Type:   string(v1beta1.RouteConditionResolvedRefs),
 				Status: metav1.ConditionFalse,
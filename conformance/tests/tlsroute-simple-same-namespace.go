@@ -46,7 +46,7 @@ var TLSRouteSimpleSameNamespace = suite.ConformanceTest{
 	},
 	Manifests: []string{"tests/tlsroute-simple-same-namespace.yaml"},
 	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
-		ns := "gateway-conformance-infra"
+		ns := suite.InfraNamespace()
 		routeNN := types.NamespacedName{Name: "gateway-conformance-infra-test", Namespace: ns}
 		gwNN := types.NamespacedName{Name: "gateway-tlsroute", Namespace: ns}
 		certNN := types.NamespacedName{Name: "tls-passthrough-checks-certificate", Namespace: ns}
@@ -63,12 +63,12 @@ var TLSRouteSimpleSameNamespace = suite.ConformanceTest{
 		if err != nil {
 			t.Fatalf("unexpected error finding TLS secret: %v", err)
 		}
-		t.Run("Simple TLS request matching TLSRoute should reach infra-backend", func(t *testing.T) {
+		suite.RunSubtest(t, "Simple TLS request matching TLSRoute should reach infra-backend", func(t *testing.T) {
 			tls.MakeTLSRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, cPem, keyPem, serverStr,
 				http.ExpectedResponse{
 					Request:   http.Request{Host: serverStr, Path: "/"},
 					Backend:   "tls-backend",
-					Namespace: "gateway-conformance-infra",
+					Namespace: suite.InfraNamespace(),
 				})
 		})
 	},
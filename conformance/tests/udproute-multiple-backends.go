@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, UDPRouteMultipleBackends)
+}
+
+var UDPRouteMultipleBackends = suite.ConformanceTest{
+	ShortName:   "UDPRouteMultipleBackends",
+	Description: "A UDPRoute with multiple weighted BackendRefs forwards traffic to all of them",
+	Features: []suite.SupportedFeature{
+		suite.SupportGateway,
+		suite.SupportUDPRoute,
+	},
+	Manifests: []string{"tests/udproute-multiple-backends.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := suite.InfraNamespace()
+		routeNN := types.NamespacedName{Name: "multiple-backends", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "gateway-udproute-multiple-backends", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), &v1alpha2.UDPRoute{}, routeNN)
+		kubernetes.RouteMustHaveResolvedRefsConditionsTrue(t, suite.Client, suite.TimeoutConfig, &v1alpha2.UDPRoute{}, routeNN, gwNN)
+
+		suite.RunSubtest(t, "UDP payloads should reach both l4-infra-backend-v1 and l4-infra-backend-v2", func(t *testing.T) {
+			req := l4.Request{Protocol: l4.ProtocolUDP, Payload: "gateway-conformance"}
+			l4.MakeRequestsAndExpectBackends(t, suite.L4RoundTripper, suite.TimeoutConfig, gwAddr, req, ns, "l4-infra-backend-v1", "l4-infra-backend-v2")
+		})
+	},
+}
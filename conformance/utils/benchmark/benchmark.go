@@ -0,0 +1,428 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package benchmark measures how long it takes for an implementation to
+// reflect changes to HTTPRoutes in its traffic, with different numbers of
+// HTTPRoutes attached to a Gateway.
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+const (
+	// Namespace is the name of the Namespace of the benchmark Gateway, backend
+	// and HTTPRoutes in the manifests.
+	Namespace = "gateway-conformance-benchmark"
+	// GatewayName is the name of the Gateway the HTTPRoutes are attached to
+	// in the manifests.
+	GatewayName = "benchmark"
+	// BackendName is the name of the Service the HTTPRoutes route to.
+	BackendName = "benchmark-backend"
+	// BackendPort is the port of the backend Service.
+	BackendPort = 8080
+
+	// DefaultManifests is the location of the benchmark manifests in the
+	// conformance Manifests.
+	DefaultManifests = "benchmark/manifests.yaml"
+	// DefaultSamples is the default number of times each operation is
+	// measured with each number of HTTPRoutes.
+	DefaultSamples = 10
+	// DefaultPollInterval is the default interval between the requests that
+	// check whether a change has propagated.
+	DefaultPollInterval = 100 * time.Millisecond
+
+	// GenerationHeader is the request header that the HTTPRoute being
+	// measured adds to the requests it routes. Its value changes with every
+	// modification of the HTTPRoute.
+	GenerationHeader = "X-Benchmark-Generation"
+	// RouteLabel is the label set on every HTTPRoute created by the
+	// benchmark.
+	RouteLabel = "gateway-conformance-benchmark"
+
+	// probeRouteName is the name of the HTTPRoute that is attached, modified
+	// and detached to measure propagation.
+	probeRouteName = "benchmark-probe"
+	// hostnameDomain is the domain of the hostnames of the HTTPRoutes.
+	hostnameDomain = "benchmark.example.com"
+)
+
+// DefaultRouteCounts are the default numbers of HTTPRoutes attached to the
+// Gateway while propagation is measured.
+var DefaultRouteCounts = []int{1, 100, 1000}
+
+// Operation is a change to an HTTPRoute whose propagation is measured.
+type Operation string
+
+const (
+	// Attach creates an HTTPRoute attached to the Gateway. It has propagated
+	// once requests for its hostname reach the backend.
+	Attach Operation = "Attach"
+	// Modify changes the header that the HTTPRoute adds to requests. It has
+	// propagated once requests reach the backend with the new value.
+	Modify Operation = "Modify"
+	// Detach deletes the HTTPRoute. It has propagated once requests for its
+	// hostname get a 404 response.
+	Detach Operation = "Detach"
+)
+
+// Operations are the operations measured by the benchmark, in the order they
+// are made for each sample.
+var Operations = []Operation{Attach, Modify, Detach}
+
+// Options configure a run of the benchmark.
+type Options struct {
+	// Client is used to apply the manifests and to wait for the Gateway.
+	Client client.Client
+	// GatewayClient is used to create, modify and delete the HTTPRoutes.
+	GatewayClient versioned.Interface
+	// RoundTripper sends the requests that check whether a change has
+	// propagated.
+	RoundTripper roundtripper.RoundTripper
+	// Applier applies the manifests. The names of the Namespace and Gateway
+	// are the ones it creates for them.
+	Applier       kubernetes.Applier
+	TimeoutConfig config.TimeoutConfig
+
+	// Manifests is the location of the manifests of the Gateway and backend,
+	// DefaultManifests if empty.
+	Manifests string
+	// GatewayAddress, if set, is the address requests are sent to instead of
+	// the address in the status of the Gateway.
+	GatewayAddress string
+
+	// RouteCounts are the numbers of HTTPRoutes attached to the Gateway while
+	// each operation is measured, including the one being measured.
+	// DefaultRouteCounts if empty.
+	RouteCounts []int
+	// Samples is the number of times each operation is measured with each
+	// number of HTTPRoutes, DefaultSamples if zero.
+	Samples int
+	// PollInterval is the interval between the requests that check whether a
+	// change has propagated, DefaultPollInterval if zero. It bounds the
+	// resolution of the latencies.
+	PollInterval time.Duration
+}
+
+// Run measures the time it takes for the attachment, modification and
+// detachment of an HTTPRoute to be reflected in the traffic through the
+// Gateway, with each of the RouteCounts numbers of HTTPRoutes attached to it.
+// The extra HTTPRoutes are synthetic ones that each route a hostname of their
+// own to the backend.
+//
+// A change has propagated when the requests made after it, every
+// PollInterval, get the expected response
+// TimeoutConfig.RequiredConsecutiveSuccesses times in a row. Its latency is
+// the time from the API request until the first of those responses. Changes
+// that don't propagate within TimeoutConfig.MaxTimeToConsistency are counted
+// as failures in the report, rather than failing the test.
+func Run(t *testing.T, opts Options) *Report {
+	t.Helper()
+
+	if opts.Manifests == "" {
+		opts.Manifests = DefaultManifests
+	}
+	if len(opts.RouteCounts) == 0 {
+		opts.RouteCounts = DefaultRouteCounts
+	}
+	if opts.Samples == 0 {
+		opts.Samples = DefaultSamples
+	}
+	if opts.PollInterval == 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	routeCounts := append([]int(nil), opts.RouteCounts...)
+	sort.Ints(routeCounts)
+	if routeCounts[0] < 1 {
+		t.Fatalf("Invalid number of HTTPRoutes %d, there must be at least one", routeCounts[0])
+	}
+
+	b := &benchmark{
+		Options: opts,
+		gateway: types.NamespacedName{Namespace: opts.Applier.Namespace(Namespace), Name: opts.Applier.GatewayName(GatewayName)},
+	}
+
+	opts.Applier.MustApplyWithCleanup(t, opts.Client, opts.TimeoutConfig, opts.Manifests, true)
+	kubernetes.NamespacesMustBeReady(t, opts.Client, opts.TimeoutConfig, []string{b.gateway.Namespace})
+	b.gwAddr = opts.GatewayAddress
+	if b.gwAddr == "" {
+		b.gwAddr, _ = kubernetes.WaitForGatewayAddress(t, opts.Client, opts.TimeoutConfig, b.gateway)
+	}
+
+	report := &Report{
+		Date:    time.Now().Format(time.RFC3339),
+		Gateway: b.gateway.String(),
+		Samples: opts.Samples,
+	}
+	for _, routes := range routeCounts {
+		t.Logf("Measuring propagation with %d HTTPRoutes attached to Gateway %s", routes, b.gateway)
+		b.attachBackgroundRoutes(t, routes-1)
+
+		latencies := map[Operation][]time.Duration{}
+		failures := map[Operation]int{}
+		record := func(operation Operation, hostname string, latency time.Duration, propagated bool) {
+			if !propagated {
+				t.Logf("%s of the HTTPRoute for %s did not propagate within %v, recording a failure", operation, hostname, opts.TimeoutConfig.MaxTimeToConsistency)
+				failures[operation]++
+				return
+			}
+			latencies[operation] = append(latencies[operation], latency)
+		}
+		for i := 0; i < opts.Samples; i++ {
+			hostname := fmt.Sprintf("probe-%d-%d.%s", routes, i, hostnameDomain)
+			latency, propagated := b.attach(t, hostname)
+			record(Attach, hostname, latency, propagated)
+			latency, propagated = b.modify(t, hostname)
+			record(Modify, hostname, latency, propagated)
+			latency, propagated = b.detach(t, hostname)
+			record(Detach, hostname, latency, propagated)
+		}
+
+		scale := ScaleReport{Routes: routes}
+		for _, operation := range Operations {
+			scale.Operations = append(scale.Operations, newOperationReport(operation, latencies[operation], failures[operation]))
+		}
+		report.Scales = append(report.Scales, scale)
+	}
+	return report
+}
+
+// ParseRouteCounts parses flag arguments and converts the string to the
+// numbers of HTTPRoutes to run the benchmark with.
+func ParseRouteCounts(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var routeCounts []int
+	for _, count := range strings.Split(s, ",") {
+		routes, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of HTTPRoutes %q: %w", count, err)
+		}
+		if routes < 1 {
+			return nil, fmt.Errorf("invalid number of HTTPRoutes %d, there must be at least one", routes)
+		}
+		routeCounts = append(routeCounts, routes)
+	}
+	return routeCounts, nil
+}
+
+type benchmark struct {
+	Options
+
+	gateway types.NamespacedName
+	gwAddr  string
+	// backgroundRoutes is the number of synthetic HTTPRoutes attached to the
+	// Gateway.
+	backgroundRoutes int
+}
+
+// attachBackgroundRoutes attaches synthetic HTTPRoutes to the Gateway until
+// there are count of them, and waits until the last one serves traffic. They
+// are deleted when the test completes.
+func (b *benchmark) attachBackgroundRoutes(t *testing.T, count int) {
+	if count <= b.backgroundRoutes {
+		return
+	}
+
+	var hostname string
+	for ; b.backgroundRoutes < count; b.backgroundRoutes++ {
+		name := fmt.Sprintf("benchmark-route-%d", b.backgroundRoutes)
+		hostname = fmt.Sprintf("route-%d.%s", b.backgroundRoutes, hostnameDomain)
+		b.createRoute(t, b.route(name, hostname, ""))
+		t.Cleanup(func() {
+			b.deleteRoute(t, name)
+		})
+	}
+
+	t.Logf("Waiting for %d synthetic HTTPRoutes to serve traffic", count)
+	_, propagated := b.awaitPropagation(hostname, time.Now(), func(cReq *roundtripper.CapturedRequest, cRes *roundtripper.CapturedResponse) bool {
+		return cRes.StatusCode == 200 && cReq.Namespace == b.gateway.Namespace
+	})
+	if !propagated {
+		t.Fatalf("The synthetic HTTPRoute for %s did not serve traffic within %v", hostname, b.TimeoutConfig.MaxTimeToConsistency)
+	}
+}
+
+// attach creates the probe HTTPRoute for hostname and returns the time it took
+// for requests for hostname to reach the backend, and whether they did.
+func (b *benchmark) attach(t *testing.T, hostname string) (time.Duration, bool) {
+	start := time.Now()
+	b.createRoute(t, b.route(probeRouteName, hostname, "1"))
+	return b.awaitPropagation(hostname, start, expectGeneration(b.gateway.Namespace, "1"))
+}
+
+// modify changes the value of the GenerationHeader the probe HTTPRoute adds
+// to requests, and returns the time it took for requests to reach the backend
+// with the new value, and whether they did.
+func (b *benchmark) modify(t *testing.T, hostname string) (time.Duration, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.TimeoutConfig.CreateTimeout)
+	defer cancel()
+
+	var start time.Time
+	routes := b.GatewayClient.GatewayV1beta1().HTTPRoutes(b.gateway.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		route, err := routes.Get(ctx, probeRouteName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		route.Spec = b.route(probeRouteName, hostname, "2").Spec
+		start = time.Now()
+		_, err = routes.Update(ctx, route, metav1.UpdateOptions{})
+		return err
+	})
+	require.NoErrorf(t, err, "error updating HTTPRoute %s", probeRouteName)
+
+	return b.awaitPropagation(hostname, start, expectGeneration(b.gateway.Namespace, "2"))
+}
+
+// detach deletes the probe HTTPRoute and returns the time it took for
+// requests for hostname to get a 404 response, and whether they did.
+func (b *benchmark) detach(t *testing.T, hostname string) (time.Duration, bool) {
+	start := time.Now()
+	b.deleteRoute(t, probeRouteName)
+	return b.awaitPropagation(hostname, start, func(_ *roundtripper.CapturedRequest, cRes *roundtripper.CapturedResponse) bool {
+		return cRes.StatusCode == 404
+	})
+}
+
+// awaitPropagation makes requests for hostname every PollInterval until check
+// accepts the responses to them TimeoutConfig.RequiredConsecutiveSuccesses
+// times in a row. It returns the time from start until the first of those
+// responses, and false if that doesn't happen within
+// TimeoutConfig.MaxTimeToConsistency of start.
+func (b *benchmark) awaitPropagation(hostname string, start time.Time, check func(*roundtripper.CapturedRequest, *roundtripper.CapturedResponse) bool) (time.Duration, bool) {
+	req := roundtripper.Request{
+		Method:   "GET",
+		Host:     hostname,
+		URL:      url.URL{Scheme: "http", Host: b.gwAddr, Path: "/"},
+		Protocol: "HTTP",
+	}
+
+	deadline := start.Add(b.TimeoutConfig.MaxTimeToConsistency)
+	var propagated time.Time
+	successes := 0
+	for {
+		cReq, cRes, err := b.RoundTripper.CaptureRoundTrip(req)
+		if err != nil || !check(cReq, cRes) {
+			successes = 0
+		} else {
+			if successes == 0 {
+				propagated = time.Now()
+			}
+			successes++
+			if successes >= b.TimeoutConfig.RequiredConsecutiveSuccesses {
+				return propagated.Sub(start), true
+			}
+		}
+		if time.Now().After(deadline) {
+			return 0, false
+		}
+		time.Sleep(b.PollInterval)
+	}
+}
+
+// route returns an HTTPRoute attached to the Gateway that routes hostname to
+// the backend. If generation is set, the HTTPRoute sets the GenerationHeader
+// of requests to it.
+func (b *benchmark) route(name, hostname, generation string) *v1beta1.HTTPRoute {
+	port := v1beta1.PortNumber(BackendPort)
+	rule := v1beta1.HTTPRouteRule{
+		BackendRefs: []v1beta1.HTTPBackendRef{{
+			BackendRef: v1beta1.BackendRef{
+				BackendObjectReference: v1beta1.BackendObjectReference{
+					Name: BackendName,
+					Port: &port,
+				},
+			},
+		}},
+	}
+	if generation != "" {
+		rule.Filters = []v1beta1.HTTPRouteFilter{{
+			Type: v1beta1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{
+				Set: []v1beta1.HTTPHeader{{Name: GenerationHeader, Value: generation}},
+			},
+		}}
+	}
+
+	return &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: b.gateway.Namespace,
+			Labels:    map[string]string{RouteLabel: "true"},
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{{Name: v1beta1.ObjectName(b.gateway.Name)}},
+			},
+			Hostnames: []v1beta1.Hostname{v1beta1.Hostname(hostname)},
+			Rules:     []v1beta1.HTTPRouteRule{rule},
+		},
+	}
+}
+
+func (b *benchmark) createRoute(t *testing.T, route *v1beta1.HTTPRoute) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.TimeoutConfig.CreateTimeout)
+	defer cancel()
+	_, err := b.GatewayClient.GatewayV1beta1().HTTPRoutes(route.Namespace).Create(ctx, route, metav1.CreateOptions{})
+	require.NoErrorf(t, err, "error creating HTTPRoute %s", route.Name)
+}
+
+func (b *benchmark) deleteRoute(t *testing.T, name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), b.TimeoutConfig.DeleteTimeout)
+	defer cancel()
+	err := b.GatewayClient.GatewayV1beta1().HTTPRoutes(b.gateway.Namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if !apierrors.IsNotFound(err) {
+		require.NoErrorf(t, err, "error deleting HTTPRoute %s", name)
+	}
+}
+
+// expectGeneration returns a check of whether a request reached the backend in
+// namespace with the GenerationHeader set to generation.
+func expectGeneration(namespace, generation string) func(*roundtripper.CapturedRequest, *roundtripper.CapturedResponse) bool {
+	return func(cReq *roundtripper.CapturedRequest, cRes *roundtripper.CapturedResponse) bool {
+		if cRes.StatusCode != 200 || cReq.Namespace != namespace {
+			return false
+		}
+		for name, values := range cReq.Headers {
+			if strings.EqualFold(name, GenerationHeader) {
+				return len(values) == 1 && values[0] == generation
+			}
+		}
+		return false
+	}
+}
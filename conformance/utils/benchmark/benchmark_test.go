@@ -0,0 +1,327 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	fakegateway "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+// standInGateway is a local stand-in for an implementation. It routes each
+// request with the HTTPRoutes attached to its Gateway at the time of the
+// request.
+type standInGateway struct {
+	client  versioned.Interface
+	gateway types.NamespacedName
+	// ignoreHeaders makes the stand-in ignore the headers that HTTPRoutes set,
+	// so that attaching and modifying them never propagates.
+	ignoreHeaders bool
+
+	lock sync.Mutex
+	// maxRoutes is the largest number of HTTPRoutes that were attached to
+	// the Gateway at once.
+	maxRoutes int
+}
+
+func (g *standInGateway) CaptureRoundTrip(request roundtripper.Request) (*roundtripper.CapturedRequest, *roundtripper.CapturedResponse, error) {
+	routes, err := g.client.GatewayV1beta1().HTTPRoutes(g.gateway.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attached := 0
+	var match *v1beta1.HTTPRoute
+	for i := range routes.Items {
+		route := &routes.Items[i]
+		if len(route.Spec.ParentRefs) != 1 || string(route.Spec.ParentRefs[0].Name) != g.gateway.Name {
+			continue
+		}
+		attached++
+		for _, hostname := range route.Spec.Hostnames {
+			if string(hostname) == request.Host {
+				match = route
+			}
+		}
+	}
+
+	g.lock.Lock()
+	if attached > g.maxRoutes {
+		g.maxRoutes = attached
+	}
+	g.lock.Unlock()
+
+	if match == nil {
+		return &roundtripper.CapturedRequest{}, &roundtripper.CapturedResponse{StatusCode: 404}, nil
+	}
+
+	headers := map[string][]string{}
+	for _, rule := range match.Spec.Rules {
+		for _, filter := range rule.Filters {
+			if filter.RequestHeaderModifier == nil || g.ignoreHeaders {
+				continue
+			}
+			for _, header := range filter.RequestHeaderModifier.Set {
+				headers[string(header.Name)] = []string{header.Value}
+			}
+		}
+	}
+	cReq := &roundtripper.CapturedRequest{
+		Path:      request.URL.Path,
+		Host:      request.Host,
+		Method:    request.Method,
+		Protocol:  "HTTP/1.1",
+		Headers:   headers,
+		Namespace: match.Namespace,
+		Pod:       BackendName,
+	}
+	return cReq, &roundtripper.CapturedResponse{StatusCode: 200, Protocol: "HTTP/1.1"}, nil
+}
+
+// standInOptions returns the options to run the benchmark against a stand-in
+// for an implementation, in a fake cluster.
+func standInOptions(t *testing.T) (Options, *standInGateway) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, appsv1.AddToScheme(scheme))
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+
+	// The manifests update the Gateway, which keeps the status the stand-in
+	// would have set.
+	addressType := v1beta1.IPAddressType
+	gateway := &v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: GatewayName, Namespace: Namespace},
+		Status: v1beta1.GatewayStatus{
+			Addresses: []v1beta1.GatewayStatusAddress{{Type: &addressType, Value: "192.0.2.1"}},
+			Conditions: []metav1.Condition{
+				{Type: string(v1beta1.GatewayConditionAccepted), Status: metav1.ConditionTrue},
+				{Type: string(v1beta1.GatewayConditionProgrammed), Status: metav1.ConditionTrue},
+			},
+		},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gateway).WithStatusSubresource(&v1beta1.Gateway{}).Build()
+	gatewayClient := fakegateway.NewSimpleClientset()
+	standIn := &standInGateway{
+		client:  gatewayClient,
+		gateway: types.NamespacedName{Namespace: Namespace, Name: GatewayName},
+	}
+
+	return Options{
+		Client:        c,
+		GatewayClient: gatewayClient,
+		RoundTripper:  standIn,
+		Applier:       kubernetes.Applier{FS: conformance.Manifests, GatewayClass: "gateway-conformance"},
+		TimeoutConfig: config.DefaultTimeoutConfig(),
+	}, standIn
+}
+
+func TestRun(t *testing.T) {
+	opts, standIn := standInOptions(t)
+	opts.RouteCounts = []int{5, 1}
+	opts.Samples = 3
+	gatewayClient := opts.GatewayClient
+
+	var report *Report
+	t.Run("Run", func(t *testing.T) {
+		report = Run(t, opts)
+	})
+	require.NotNil(t, report)
+
+	assert.Equal(t, "gateway-conformance-benchmark/benchmark", report.Gateway)
+	assert.Equal(t, 3, report.Samples)
+	require.Len(t, report.Scales, 2)
+	for i, routes := range []int{1, 5} {
+		scale := report.Scales[i]
+		assert.Equal(t, routes, scale.Routes)
+		require.Len(t, scale.Operations, len(Operations))
+		for j, operation := range scale.Operations {
+			assert.Equal(t, Operations[j], operation.Operation)
+			assert.Equal(t, 3, operation.Samples)
+			assert.Zero(t, operation.Failures)
+			assert.LessOrEqual(t, operation.Min.Duration, operation.P50.Duration)
+			assert.LessOrEqual(t, operation.P50.Duration, operation.P99.Duration)
+			assert.LessOrEqual(t, operation.P99.Duration, operation.Max.Duration)
+		}
+	}
+
+	assert.Equal(t, 5, standIn.maxRoutes, "expected the synthetic and measured HTTPRoutes to be attached at once")
+	routes, err := gatewayClient.GatewayV1beta1().HTTPRoutes(Namespace).List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, routes.Items, "expected the HTTPRoutes to be deleted")
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, WriteReport(path, report))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	written := &Report{}
+	require.NoError(t, json.Unmarshal(data, written))
+	assert.Equal(t, report, written)
+}
+
+func TestRunRecordsFailures(t *testing.T) {
+	opts, standIn := standInOptions(t)
+	standIn.ignoreHeaders = true
+	opts.RouteCounts = []int{1}
+	opts.Samples = 2
+	opts.TimeoutConfig.MaxTimeToConsistency = 100 * time.Millisecond
+	opts.PollInterval = 10 * time.Millisecond
+
+	var report *Report
+	succeeded := t.Run("Run", func(t *testing.T) {
+		report = Run(t, opts)
+	})
+	require.True(t, succeeded, "expected changes that don't propagate not to fail the test")
+	require.NotNil(t, report)
+
+	require.Len(t, report.Scales, 1)
+	expectedFailures := map[Operation]int{Attach: 2, Modify: 2, Detach: 0}
+	for _, operation := range report.Scales[0].Operations {
+		assert.Equal(t, 2, operation.Samples, "samples of %s", operation.Operation)
+		assert.Equal(t, expectedFailures[operation.Operation], operation.Failures, "failures of %s", operation.Operation)
+	}
+}
+
+func TestNewOperationReport(t *testing.T) {
+	var latencies []time.Duration
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	testCases := []struct {
+		name      string
+		latencies []time.Duration
+		failures  int
+		expected  OperationReport
+	}{{
+		name:     "no samples",
+		expected: OperationReport{Operation: Attach},
+	}, {
+		name:      "one sample",
+		latencies: []time.Duration{time.Second},
+		expected: OperationReport{
+			Operation: Attach,
+			Samples:   1,
+			P50:       metav1.Duration{Duration: time.Second},
+			P99:       metav1.Duration{Duration: time.Second},
+			Min:       metav1.Duration{Duration: time.Second},
+			Max:       metav1.Duration{Duration: time.Second},
+		},
+	}, {
+		name:      "three samples",
+		latencies: []time.Duration{3 * time.Second, time.Second, 2 * time.Second},
+		expected: OperationReport{
+			Operation: Attach,
+			Samples:   3,
+			P50:       metav1.Duration{Duration: 2 * time.Second},
+			P99:       metav1.Duration{Duration: 3 * time.Second},
+			Min:       metav1.Duration{Duration: time.Second},
+			Max:       metav1.Duration{Duration: 3 * time.Second},
+		},
+	}, {
+		name:      "failed samples",
+		latencies: []time.Duration{2 * time.Second, time.Second},
+		failures:  2,
+		expected: OperationReport{
+			Operation: Attach,
+			Samples:   4,
+			Failures:  2,
+			P50:       metav1.Duration{Duration: time.Second},
+			P99:       metav1.Duration{Duration: 2 * time.Second},
+			Min:       metav1.Duration{Duration: time.Second},
+			Max:       metav1.Duration{Duration: 2 * time.Second},
+		},
+	}, {
+		name:     "only failed samples",
+		failures: 3,
+		expected: OperationReport{Operation: Attach, Samples: 3, Failures: 3},
+	}, {
+		name:      "hundred samples",
+		latencies: latencies,
+		expected: OperationReport{
+			Operation: Attach,
+			Samples:   100,
+			P50:       metav1.Duration{Duration: 50 * time.Millisecond},
+			P99:       metav1.Duration{Duration: 99 * time.Millisecond},
+			Min:       metav1.Duration{Duration: time.Millisecond},
+			Max:       metav1.Duration{Duration: 100 * time.Millisecond},
+		},
+	}}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, newOperationReport(Attach, tc.latencies, tc.failures))
+		})
+	}
+}
+
+func TestParseRouteCounts(t *testing.T) {
+	testCases := []struct {
+		name        string
+		routeCounts string
+		expected    []int
+		expectedErr bool
+	}{{
+		name: "empty",
+	}, {
+		name:        "counts",
+		routeCounts: "1, 100,1000",
+		expected:    []int{1, 100, 1000},
+	}, {
+		name:        "not a number",
+		routeCounts: "1,many",
+		expectedErr: true,
+	}, {
+		name:        "zero",
+		routeCounts: "0",
+		expectedErr: true,
+	}}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			routeCounts, err := ParseRouteCounts(tc.routeCounts)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, routeCounts)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package benchmark
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Report is the result of a run of the benchmark.
+type Report struct {
+	// Date is when the benchmark ran, in RFC 3339 format.
+	Date string `json:"date"`
+	// Gateway is the namespace/name of the Gateway the HTTPRoutes were
+	// attached to.
+	Gateway string `json:"gateway"`
+	// Samples is the number of times each operation was measured with each
+	// number of HTTPRoutes.
+	Samples int `json:"samples"`
+	// Scales are the results for each number of HTTPRoutes, from the lowest
+	// number to the highest.
+	Scales []ScaleReport `json:"scales"`
+}
+
+// ScaleReport is the result of the benchmark with a number of HTTPRoutes
+// attached to the Gateway.
+type ScaleReport struct {
+	// Routes is the number of HTTPRoutes attached to the Gateway, including
+	// the one being changed.
+	Routes int `json:"routes"`
+	// Operations are the propagation latencies of each operation.
+	Operations []OperationReport `json:"operations"`
+}
+
+// OperationReport is the distribution of the propagation latencies of an
+// operation.
+type OperationReport struct {
+	Operation Operation `json:"operation"`
+	// Samples is the number of times the operation was measured, including
+	// the Failures.
+	Samples int `json:"samples"`
+	// Failures is the number of times the operation did not propagate within
+	// MaxTimeToConsistency. The latencies are those of the other samples.
+	Failures int             `json:"failures"`
+	P50      metav1.Duration `json:"p50"`
+	P99      metav1.Duration `json:"p99"`
+	Min      metav1.Duration `json:"min"`
+	Max      metav1.Duration `json:"max"`
+}
+
+// newOperationReport returns the distribution of the latencies of operation,
+// which failed to propagate failures more times.
+func newOperationReport(operation Operation, latencies []time.Duration, failures int) OperationReport {
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	report := OperationReport{Operation: operation, Samples: len(sorted) + failures, Failures: failures}
+	if len(sorted) == 0 {
+		return report
+	}
+	report.P50 = metav1.Duration{Duration: percentile(sorted, 50)}
+	report.P99 = metav1.Duration{Duration: percentile(sorted, 99)}
+	report.Min = metav1.Duration{Duration: sorted[0]}
+	report.Max = metav1.Duration{Duration: sorted[len(sorted)-1]}
+	return report
+}
+
+// percentile returns the p-th percentile of the sorted latencies, using the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// WriteReport writes the report to path as indented JSON.
+func WriteReport(path string, report *Report) error {
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0o644)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// MaxConformantTimeToConsistency is the highest MaxTimeToConsistency an
+// implementation can be tested with to claim conformance.
+const MaxConformantTimeToConsistency = 30 * time.Second
+
+// NonConformantTimeout is a timeout that exceeds the maximum value for a
+// conformant implementation.
+type NonConformantTimeout struct {
+	// Name is the name of the TimeoutConfig field.
+	Name  string
+	Value time.Duration
+	Max   time.Duration
+}
+
+// LoadTimeoutConfig sets the timeouts in timeoutConfig to the ones in the
+// YAML or JSON file at path. The file has a field for each timeout, named
+// after the TimeoutConfig field in lower camel case, e.g.
+// gatewayMustHaveAddress. Durations are strings in the format accepted by
+// time.ParseDuration, e.g. "5m". Timeouts that are not in the file are left
+// unchanged.
+func LoadTimeoutConfig(path string, timeoutConfig *TimeoutConfig) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, timeoutConfig); err != nil {
+		return fmt.Errorf("error parsing timeout config %s: %w", path, err)
+	}
+	return nil
+}
+
+// UnmarshalJSON sets the timeouts that are set in data, where durations are
+// strings in the format accepted by time.ParseDuration. It returns an error
+// for fields that are not timeouts.
+func (c *TimeoutConfig) UnmarshalJSON(data []byte) error {
+	values := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	config := reflect.ValueOf(c).Elem()
+	for name, value := range values {
+		field, ok := timeoutConfigField(config, name)
+		if !ok {
+			return fmt.Errorf("unknown timeout %q", name)
+		}
+
+		if field.Type() != reflect.TypeOf(time.Duration(0)) {
+			if err := json.Unmarshal(value, field.Addr().Interface()); err != nil {
+				return fmt.Errorf("invalid value for %s: %w", name, err)
+			}
+			continue
+		}
+		var duration string
+		if err := json.Unmarshal(value, &duration); err != nil {
+			return fmt.Errorf("invalid value for %s, expected a duration such as \"30s\": %w", name, err)
+		}
+		d, err := time.ParseDuration(duration)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %w", name, err)
+		}
+		field.SetInt(int64(d))
+	}
+	return nil
+}
+
+// MarshalJSON encodes the timeouts in the format read by UnmarshalJSON.
+func (c TimeoutConfig) MarshalJSON() ([]byte, error) {
+	values := map[string]interface{}{}
+	config := reflect.ValueOf(c)
+	for i := 0; i < config.NumField(); i++ {
+		name, _, _ := strings.Cut(config.Type().Field(i).Tag.Get("json"), ",")
+		if duration, ok := config.Field(i).Interface().(time.Duration); ok {
+			values[name] = duration.String()
+			continue
+		}
+		values[name] = config.Field(i).Interface()
+	}
+	return json.Marshal(values)
+}
+
+// timeoutConfigField returns the field of config with the JSON name.
+func timeoutConfigField(config reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < config.NumField(); i++ {
+		tag, _, _ := strings.Cut(config.Type().Field(i).Tag.Get("json"), ",")
+		if tag == name {
+			return config.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// OverrideTimeoutConfig sets the timeouts in timeoutConfig to the ones that
+// are set in overrides, i.e. that are not zero.
+func OverrideTimeoutConfig(timeoutConfig *TimeoutConfig, overrides TimeoutConfig) {
+	config := reflect.ValueOf(timeoutConfig).Elem()
+	override := reflect.ValueOf(overrides)
+	for i := 0; i < override.NumField(); i++ {
+		if !override.Field(i).IsZero() {
+			config.Field(i).Set(override.Field(i))
+		}
+	}
+}
+
+// ValidateTimeoutConfig returns an error if a timeout is not positive or if
+// RequiredConsecutiveSuccesses is less than one.
+func ValidateTimeoutConfig(timeoutConfig TimeoutConfig) error {
+	config := reflect.ValueOf(timeoutConfig)
+	for i := 0; i < config.NumField(); i++ {
+		field := config.Type().Field(i)
+		if field.Type == reflect.TypeOf(time.Duration(0)) && config.Field(i).Int() <= 0 {
+			return fmt.Errorf("%s must be positive, got %v", field.Name, time.Duration(config.Field(i).Int()))
+		}
+	}
+	if timeoutConfig.RequiredConsecutiveSuccesses < 1 {
+		return fmt.Errorf("RequiredConsecutiveSuccesses must be at least 1, got %d", timeoutConfig.RequiredConsecutiveSuccesses)
+	}
+	return nil
+}
+
+// NonConformantTimeouts returns the timeouts that exceed the maximum value
+// for a conformant implementation. Results of a test run with any of them
+// can't be used to claim conformance.
+func NonConformantTimeouts(timeoutConfig TimeoutConfig) []NonConformantTimeout {
+	var timeouts []NonConformantTimeout
+	if timeoutConfig.MaxTimeToConsistency > MaxConformantTimeToConsistency {
+		timeouts = append(timeouts, NonConformantTimeout{
+			Name:  "MaxTimeToConsistency",
+			Value: timeoutConfig.MaxTimeToConsistency,
+			Max:   MaxConformantTimeToConsistency,
+		})
+	}
+	return timeouts
+}
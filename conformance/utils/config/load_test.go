@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTimeoutConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		file        string
+		expected    func(*TimeoutConfig)
+		expectedErr bool
+	}{{
+		name: "yaml",
+		file: "gatewayMustHaveAddress: 5m\ngwcMustBeAccepted: 90s\nrequiredConsecutiveSuccesses: 5\n",
+		expected: func(c *TimeoutConfig) {
+			c.GatewayMustHaveAddress = 5 * time.Minute
+			c.GWCMustBeAccepted = 90 * time.Second
+			c.RequiredConsecutiveSuccesses = 5
+		},
+	}, {
+		name: "json",
+		file: `{"namespacesMustBeReady": "10m", "maxTimeToConsistency": "45s"}`,
+		expected: func(c *TimeoutConfig) {
+			c.NamespacesMustBeReady = 10 * time.Minute
+			c.MaxTimeToConsistency = 45 * time.Second
+		},
+	}, {
+		name:        "unknown timeout",
+		file:        "gatewayMustHaveAnAddress: 5m\n",
+		expectedErr: true,
+	}, {
+		name:        "invalid duration",
+		file:        "createTimeout: soon\n",
+		expectedErr: true,
+	}, {
+		name:        "duration without unit",
+		file:        "createTimeout: 60\n",
+		expectedErr: true,
+	}}
+
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "timeouts")
+			require.NoError(t, os.WriteFile(path, []byte(tc.file), 0o644))
+
+			timeoutConfig := DefaultTimeoutConfig()
+			err := LoadTimeoutConfig(path, &timeoutConfig)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			expected := DefaultTimeoutConfig()
+			tc.expected(&expected)
+			assert.Equal(t, expected, timeoutConfig)
+		})
+	}
+}
+
+func TestTimeoutConfigJSON(t *testing.T) {
+	data, err := json.Marshal(DefaultTimeoutConfig())
+	require.NoError(t, err)
+
+	var timeoutConfig TimeoutConfig
+	require.NoError(t, json.Unmarshal(data, &timeoutConfig))
+	assert.Equal(t, DefaultTimeoutConfig(), timeoutConfig)
+}
+
+func TestOverrideTimeoutConfig(t *testing.T) {
+	timeoutConfig := DefaultTimeoutConfig()
+	OverrideTimeoutConfig(&timeoutConfig, TimeoutConfig{GetTimeout: time.Minute, RequiredConsecutiveSuccesses: 2})
+
+	expected := DefaultTimeoutConfig()
+	expected.GetTimeout = time.Minute
+	expected.RequiredConsecutiveSuccesses = 2
+	assert.Equal(t, expected, timeoutConfig)
+}
+
+func TestValidateTimeoutConfig(t *testing.T) {
+	assert.NoError(t, ValidateTimeoutConfig(DefaultTimeoutConfig()))
+
+	timeoutConfig := DefaultTimeoutConfig()
+	timeoutConfig.RequestTimeout = 0
+	assert.EqualError(t, ValidateTimeoutConfig(timeoutConfig), "RequestTimeout must be positive, got 0s")
+
+	timeoutConfig = DefaultTimeoutConfig()
+	timeoutConfig.RequiredConsecutiveSuccesses = 0
+	assert.EqualError(t, ValidateTimeoutConfig(timeoutConfig), "RequiredConsecutiveSuccesses must be at least 1, got 0")
+}
+
+func TestNonConformantTimeouts(t *testing.T) {
+	timeoutConfig := DefaultTimeoutConfig()
+	timeoutConfig.NamespacesMustBeReady = time.Hour
+	assert.Empty(t, NonConformantTimeouts(timeoutConfig), "expected timeouts without a conformant maximum to be conformant")
+
+	timeoutConfig.MaxTimeToConsistency = time.Minute
+	assert.Equal(t, []NonConformantTimeout{{
+		Name:  "MaxTimeToConsistency",
+		Value: time.Minute,
+		Max:   30 * time.Second,
+	}}, NonConformantTimeouts(timeoutConfig))
+}
@@ -21,71 +21,75 @@ import "time"
 type TimeoutConfig struct {
 	// CreateTimeout represents the maximum time for a Kubernetes object to be created.
 	// Max value for conformant implementation: None
-	CreateTimeout time.Duration
+	CreateTimeout time.Duration `json:"createTimeout,omitempty"`
 
 	// DeleteTimeout represents the maximum time for a Kubernetes object to be deleted.
 	// Max value for conformant implementation: None
-	DeleteTimeout time.Duration
+	DeleteTimeout time.Duration `json:"deleteTimeout,omitempty"`
 
 	// GetTimeout represents the maximum time to get a Kubernetes object.
 	// Max value for conformant implementation: None
-	GetTimeout time.Duration
+	GetTimeout time.Duration `json:"getTimeout,omitempty"`
 
 	// GatewayMustHaveAddress represents the maximum time for at least one IP Address has been set in the status of a Gateway.
 	// Max value for conformant implementation: None
-	GatewayMustHaveAddress time.Duration
+	GatewayMustHaveAddress time.Duration `json:"gatewayMustHaveAddress,omitempty"`
 
 	// GatewayStatusMustHaveListeners represents the maximum time for a Gateway to have listeners in status that match the expected listeners.
 	// Max value for conformant implementation: None
-	GatewayStatusMustHaveListeners time.Duration
+	GatewayStatusMustHaveListeners time.Duration `json:"gatewayStatusMustHaveListeners,omitempty"`
 
 	// GWCMustBeAccepted represents the maximum time for a GatewayClass to have an Accepted condition set to true.
 	// Max value for conformant implementation: None
-	GWCMustBeAccepted time.Duration
+	GWCMustBeAccepted time.Duration `json:"gwcMustBeAccepted,omitempty"`
 
 	// HTTPRouteMustNotHaveParents represents the maximum time for an HTTPRoute to have either no parents or a single parent that is not accepted.
 	// Max value for conformant implementation: None
-	HTTPRouteMustNotHaveParents time.Duration
+	HTTPRouteMustNotHaveParents time.Duration `json:"httpRouteMustNotHaveParents,omitempty"`
 
 	// HTTPRouteMustHaveCondition represents the maximum time for an HTTPRoute to have the supplied Condition.
 	// Max value for conformant implementation: None
-	HTTPRouteMustHaveCondition time.Duration
+	HTTPRouteMustHaveCondition time.Duration `json:"httpRouteMustHaveCondition,omitempty"`
 
 	// TLSRouteMustHaveCondition represents the maximum time for an TLSRoute to have the supplied Condition.
 	// Max value for conformant implementation: None
-	TLSRouteMustHaveCondition time.Duration
+	TLSRouteMustHaveCondition time.Duration `json:"tlsRouteMustHaveCondition,omitempty"`
+
+	// RouteMustHaveCondition represents the maximum time for an xRoute without a dedicated timeout, such as a GRPCRoute, TCPRoute or UDPRoute, to have the supplied Condition.
+	// Max value for conformant implementation: None
+	RouteMustHaveCondition time.Duration `json:"routeMustHaveCondition,omitempty"`
 
 	// RouteMustHaveParents represents the maximum time for an xRoute to have parents in status that match the expected parents.
 	// Max value for conformant implementation: None
-	RouteMustHaveParents time.Duration
+	RouteMustHaveParents time.Duration `json:"routeMustHaveParents,omitempty"`
 
 	// ManifestFetchTimeout represents the maximum time for getting content from a https:// URL.
 	// Max value for conformant implementation: None
-	ManifestFetchTimeout time.Duration
+	ManifestFetchTimeout time.Duration `json:"manifestFetchTimeout,omitempty"`
 
 	// MaxTimeToConsistency is the maximum time for requiredConsecutiveSuccesses (default 3) requests to succeed in a row before failing the test.
 	// Max value for conformant implementation: 30 seconds
-	MaxTimeToConsistency time.Duration
+	MaxTimeToConsistency time.Duration `json:"maxTimeToConsistency,omitempty"`
 
 	// NamespacesMustBeReady represents the maximum time for the following to happen within
 	// specified namespace(s):
 	// * All Pods to be marked as "Ready"
 	// * All Gateways to be marked as "Accepted" and "Programmed"
 	// Max value for conformant implementation: None
-	NamespacesMustBeReady time.Duration
+	NamespacesMustBeReady time.Duration `json:"namespacesMustBeReady,omitempty"`
 
 	// RequestTimeout represents the maximum time for making an HTTP Request with the roundtripper.
 	// Max value for conformant implementation: None
-	RequestTimeout time.Duration
+	RequestTimeout time.Duration `json:"requestTimeout,omitempty"`
 
 	// LatestObservedGenerationSet represents the maximum time for an ObservedGeneration to bump.
 	// Max value for conformant implementation: None
-	LatestObservedGenerationSet time.Duration
+	LatestObservedGenerationSet time.Duration `json:"latestObservedGenerationSet,omitempty"`
 
 	// RequiredConsecutiveSuccesses is the number of requests that must succeed in a row
 	// to consider a response "consistent" before making additional assertions on the response body.
 	// If this number is not reached within MaxTimeToConsistency, the test will fail.
-	RequiredConsecutiveSuccesses int
+	RequiredConsecutiveSuccesses int `json:"requiredConsecutiveSuccesses,omitempty"`
 }
 
 // DefaultTimeoutConfig populates a TimeoutConfig with the default values.
@@ -100,6 +104,7 @@ func DefaultTimeoutConfig() TimeoutConfig {
 		HTTPRouteMustNotHaveParents:    60 * time.Second,
 		HTTPRouteMustHaveCondition:     60 * time.Second,
 		TLSRouteMustHaveCondition:      60 * time.Second,
+		RouteMustHaveCondition:         60 * time.Second,
 		RouteMustHaveParents:           60 * time.Second,
 		ManifestFetchTimeout:           10 * time.Second,
 		MaxTimeToConsistency:           30 * time.Second,
@@ -157,4 +162,7 @@ func SetupTimeoutConfig(timeoutConfig *TimeoutConfig) {
 	if timeoutConfig.TLSRouteMustHaveCondition == 0 {
 		timeoutConfig.TLSRouteMustHaveCondition = defaultTimeoutConfig.TLSRouteMustHaveCondition
 	}
+	if timeoutConfig.RouteMustHaveCondition == 0 {
+		timeoutConfig.RouteMustHaveCondition = defaultTimeoutConfig.RouteMustHaveCondition
+	}
 }
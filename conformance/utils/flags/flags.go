@@ -21,6 +21,9 @@ package flags
 
 import (
 	"flag"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
 var (
@@ -29,7 +32,44 @@ var (
 	CleanupBaseResources       = flag.Bool("cleanup-base-resources", true, "Whether to cleanup base test resources after the run")
 	SupportedFeatures          = flag.String("supported-features", "", "Supported features included in conformance tests suites")
 	SkipTests                  = flag.String("skip-tests", "", "Comma-separated list of tests to skip")
+	RunTests                   = flag.String("run-tests", "", "Comma-separated list of the only tests to run")
+	TestPattern                = flag.String("test-pattern", "", "Regular expression matching the names of the only tests to run")
+	ListTests                  = flag.Bool("list-tests", false, "Print the tests that would run, with their features and manifests, without running them")
 	ExemptFeatures             = flag.String("exempt-features", "", "Exempt Features excluded from conformance tests suites")
 	EnableAllSupportedFeatures = flag.Bool("all-features", false, "Whether to enable all supported features for conformance tests")
 	NamespaceLabels            = flag.String("namespace-labels", "", "Comma-separated list of name=value labels to add to test namespaces")
+	NamespacePrefix            = flag.String("namespace-prefix", "", "Prefix to add to the names of all test namespaces, so that several runs can share a cluster")
+	ParallelTests              = flag.Int("parallel-tests", 0, "Maximum number of tests to run in parallel, each in its own namespaces, among the tests that support it; 0 runs all tests serially")
+	ResultsDir                 = flag.String("results-dir", "", "Directory to write per-test results to, as JUnit XML (junit.xml) and a stream of JSON objects (results.json)")
+	ArtifactsDir               = flag.String("artifacts-dir", "", "Directory to write diagnostics of failed tests to, such as Gateway API resources, events, Pod logs and the last requests, in a directory per test")
+	BenchmarkRouteCounts       = flag.String("benchmark-route-counts", "", "Comma-separated numbers of HTTPRoutes attached to a Gateway to measure HTTPRoute propagation latency with, e.g. 1,100,1000; the benchmark is skipped if empty")
+	BenchmarkSamples           = flag.Int("benchmark-samples", 10, "Number of times the propagation of each HTTPRoute operation is measured with each number of HTTPRoutes")
+	BenchmarkReport            = flag.String("benchmark-report", "", "File to write the JSON report of the propagation benchmark to")
+	BenchmarkPollInterval      = flag.Duration("benchmark-poll-interval", 100*time.Millisecond, "Interval between the requests that check whether an HTTPRoute change has propagated")
+	TimeoutConfig              = flag.String("timeout-config", "", "YAML or JSON file with the timeouts to use instead of the defaults, e.g. gatewayMustHaveAddress: 5m")
 )
+
+// TimeoutOverrides are the timeouts set with the flag for each field of
+// config.TimeoutConfig. They take precedence over -timeout-config. The
+// timeouts whose flag is not set are zero.
+var TimeoutOverrides config.TimeoutConfig
+
+func init() {
+	flag.DurationVar(&TimeoutOverrides.CreateTimeout, "create-timeout", 0, "Maximum time for a Kubernetes object to be created")
+	flag.DurationVar(&TimeoutOverrides.DeleteTimeout, "delete-timeout", 0, "Maximum time for a Kubernetes object to be deleted")
+	flag.DurationVar(&TimeoutOverrides.GetTimeout, "get-timeout", 0, "Maximum time to get a Kubernetes object")
+	flag.DurationVar(&TimeoutOverrides.GatewayMustHaveAddress, "gateway-must-have-address-timeout", 0, "Maximum time for a Gateway to have an IP address in its status")
+	flag.DurationVar(&TimeoutOverrides.GatewayStatusMustHaveListeners, "gateway-status-must-have-listeners-timeout", 0, "Maximum time for a Gateway to have the expected listeners in its status")
+	flag.DurationVar(&TimeoutOverrides.GWCMustBeAccepted, "gwc-must-be-accepted-timeout", 0, "Maximum time for a GatewayClass to be Accepted")
+	flag.DurationVar(&TimeoutOverrides.HTTPRouteMustNotHaveParents, "httproute-must-not-have-parents-timeout", 0, "Maximum time for an HTTPRoute to have no accepted parents")
+	flag.DurationVar(&TimeoutOverrides.HTTPRouteMustHaveCondition, "httproute-must-have-condition-timeout", 0, "Maximum time for an HTTPRoute to have a condition")
+	flag.DurationVar(&TimeoutOverrides.TLSRouteMustHaveCondition, "tlsroute-must-have-condition-timeout", 0, "Maximum time for a TLSRoute to have a condition")
+	flag.DurationVar(&TimeoutOverrides.RouteMustHaveCondition, "route-must-have-condition-timeout", 0, "Maximum time for other routes, such as GRPCRoutes and TCPRoutes, to have a condition")
+	flag.DurationVar(&TimeoutOverrides.RouteMustHaveParents, "route-must-have-parents-timeout", 0, "Maximum time for a route to have the expected parents in its status")
+	flag.DurationVar(&TimeoutOverrides.ManifestFetchTimeout, "manifest-fetch-timeout", 0, "Maximum time to fetch manifests from a URL")
+	flag.DurationVar(&TimeoutOverrides.MaxTimeToConsistency, "max-time-to-consistency", 0, "Maximum time for requests to succeed the required number of times in a row; more than 30s is not conformant")
+	flag.DurationVar(&TimeoutOverrides.NamespacesMustBeReady, "namespaces-must-be-ready-timeout", 0, "Maximum time for the Pods and Gateways in the test namespaces to be ready")
+	flag.DurationVar(&TimeoutOverrides.RequestTimeout, "request-timeout", 0, "Maximum time for an HTTP request")
+	flag.DurationVar(&TimeoutOverrides.LatestObservedGenerationSet, "latest-observed-generation-set-timeout", 0, "Maximum time for the observedGeneration of a status to be updated")
+	flag.IntVar(&TimeoutOverrides.RequiredConsecutiveSuccesses, "required-consecutive-successes", 0, "Number of requests that must succeed in a row for a response to be consistent")
+}
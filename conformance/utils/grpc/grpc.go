@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+)
+
+// EchoService is the service name used by conformance tests. echo-basic
+// answers calls to any service, so tests may use other names too.
+const EchoService = "gateway_api_conformance.echo_basic.grpcecho.GrpcEcho"
+
+// ExpectedResponse defines the response expected for a given call.
+type ExpectedResponse struct {
+	// Request defines the call to make. Request.Address is set to the
+	// Gateway address.
+	Request Request
+
+	// ExpectedRequest defines the call that is expected to arrive at the
+	// backend. If not specified, the backend call will be expected to
+	// match Request.
+	ExpectedRequest *ExpectedRequest
+
+	// BackendSetResponseHeaders is a set of header metadata the echo
+	// backend should set in its response.
+	BackendSetResponseHeaders map[string]string
+
+	// Response defines what response the test case should receive.
+	Response Response
+
+	Backend   string
+	Namespace string
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// ExpectedRequest defines expected properties of a call that reaches a
+// backend.
+type ExpectedRequest struct {
+	Request
+
+	// AbsentHeaders are names of header metadata that are expected *not*
+	// to be present on the call.
+	AbsentHeaders []string
+}
+
+// Response defines expected properties of a response.
+type Response struct {
+	// Code is the expected gRPC status code, defaulting to OK.
+	Code          codes.Code
+	Headers       map[string]string
+	AbsentHeaders []string
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse makes a gRPC call with the
+// given parameters, understanding that the call may fail for some amount of
+// time.
+//
+// Once the call succeeds consistently with the response having the expected
+// status code, make additional assertions on the response using the provided
+// ExpectedResponse.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	req := expected.Request
+	req.Address = gwAddr
+	req.Headers = map[string]string{}
+	for name, value := range expected.Request.Headers {
+		req.Headers[name] = value
+	}
+	backendSetHeaders := []string{}
+	for name, val := range expected.BackendSetResponseHeaders {
+		backendSetHeaders = append(backendSetHeaders, name+":"+val)
+	}
+	if len(backendSetHeaders) > 0 {
+		req.Headers["X-Echo-Set-Header"] = strings.Join(backendSetHeaders, ",")
+	}
+
+	t.Logf("Making gRPC call %s to %s", req.FullyQualifiedMethod(), gwAddr)
+
+	http.AwaitConvergence(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency, func(elapsed time.Duration) bool {
+		cReq, cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			t.Logf("gRPC call failed, not ready yet: %v (after %v)", err.Error(), elapsed)
+			return false
+		}
+
+		if err := CompareRequest(cReq, cRes, expected); err != nil {
+			t.Logf("Response expectation failed for call %s: not ready yet: %v (after %v)", req.FullyQualifiedMethod(), err, elapsed)
+			return false
+		}
+
+		return true
+	})
+	t.Logf("gRPC call passed")
+}
+
+// CompareRequest compares the call captured by the backend and the response
+// to expected.
+func CompareRequest(cReq *CapturedRequest, cRes *CapturedResponse, expected ExpectedResponse) error {
+	if expected.Response.Code != cRes.Code {
+		return fmt.Errorf("expected status code to be %s, got %s (%s)", expected.Response.Code, cRes.Code, cRes.Message)
+	}
+	if cRes.Code != codes.OK {
+		return nil
+	}
+
+	if expected.ExpectedRequest == nil {
+		expected.ExpectedRequest = &ExpectedRequest{Request: expected.Request}
+	}
+	if want := expected.ExpectedRequest.FullyQualifiedMethod(); want != cReq.FullyQualifiedMethod {
+		return fmt.Errorf("expected method to be %s, got %s", want, cReq.FullyQualifiedMethod)
+	}
+	if expected.Namespace != cReq.Namespace {
+		return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cReq.Namespace)
+	}
+	if !strings.HasPrefix(cReq.Pod, expected.Backend) {
+		return fmt.Errorf("expected pod name to start with %s, got %s", expected.Backend, cReq.Pod)
+	}
+
+	if err := compareHeaders("request", expected.ExpectedRequest.Headers, expected.ExpectedRequest.AbsentHeaders, cReq.Headers); err != nil {
+		return err
+	}
+	return compareHeaders("response", expected.Response.Headers, expected.Response.AbsentHeaders, cRes.Headers)
+}
+
+// compareHeaders compares metadata, whose keys are lowercase, to the expected
+// and absent headers. Multiple expected values are comma-separated.
+func compareHeaders(kind string, expected map[string]string, absent []string, metadata map[string][]string) error {
+	for name, expectedVal := range expected {
+		actualVal, ok := metadata[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("expected %s %s header to be set, actual headers: %v", kind, name, metadata)
+		} else if strings.Join(actualVal, ",") != expectedVal {
+			return fmt.Errorf("expected %s %s header to be set to %s, got %s", kind, name, expectedVal, strings.Join(actualVal, ","))
+		}
+	}
+	for _, name := range absent {
+		if val, ok := metadata[strings.ToLower(name)]; ok {
+			return fmt.Errorf("expected %s %s header to not be set, got %s", kind, name, val)
+		}
+	}
+	return nil
+}
+
+// GetTestCaseName gets the user-defined test case name or generates one from
+// the expected response to a given call.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+
+	headerStr := ""
+	if er.Request.Headers != nil {
+		headerStr = " with headers"
+	}
+	reqStr := fmt.Sprintf("%d call to '%s%s'%s", i, er.Request.Host, er.Request.FullyQualifiedMethod(), headerStr)
+
+	if er.Backend != "" {
+		return fmt.Sprintf("%s should go to %s", reqStr, er.Backend)
+	}
+	return fmt.Sprintf("%s should receive a %s", reqStr, er.Response.Code)
+}
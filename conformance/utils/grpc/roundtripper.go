@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// RoundTripper is an interface used to make gRPC calls within conformance
+// tests. This can be overridden with custom implementations whenever
+// necessary.
+type RoundTripper interface {
+	CaptureRoundTrip(Request) (*CapturedRequest, *CapturedResponse, error)
+}
+
+// Request is the primary input for making a gRPC call.
+type Request struct {
+	// Address is the host:port to connect to, usually the Gateway address.
+	Address string
+	// Host is the authority of the call, defaulting to Address.
+	Host string
+	// Service and Method are the fully qualified service name, e.g.
+	// gateway_api_conformance.echo_basic.grpcecho.GrpcEcho, and the method
+	// name of the call.
+	Service string
+	Method  string
+	// Headers are sent as request metadata.
+	Headers map[string]string
+}
+
+// FullyQualifiedMethod returns the method of r as sent on the wire, e.g.
+// /package.Service/Method.
+func (r Request) FullyQualifiedMethod() string {
+	return fmt.Sprintf("/%s/%s", r.Service, r.Method)
+}
+
+// CapturedRequest contains the call metadata captured from an echo-basic
+// gRPC response.
+type CapturedRequest struct {
+	FullyQualifiedMethod string              `json:"fullyQualifiedMethod"`
+	Headers              map[string][]string `json:"headers"`
+
+	Namespace string `json:"namespace"`
+	Pod       string `json:"pod"`
+}
+
+// CapturedResponse contains the status and header metadata of the response.
+type CapturedResponse struct {
+	Code    codes.Code
+	Message string
+	Headers map[string][]string
+}
+
+// DefaultRoundTripper is the default implementation of a gRPC RoundTripper.
+// It sends unary calls over HTTP/2 cleartext with a google.protobuf.Struct
+// payload, which echo-basic answers for any service and method.
+type DefaultRoundTripper struct {
+	Debug             bool
+	TimeoutConfig     config.TimeoutConfig
+	CustomDialContext func(context.Context, string, string) (net.Conn, error)
+}
+
+// CaptureRoundTrip makes a unary call with the provided parameters and
+// returns the request captured by the backend and the response. An error is
+// only returned if the call could not be made; a call failing with a gRPC
+// status is captured in the response.
+func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	if request.Host != "" {
+		opts = append(opts, grpc.WithAuthority(request.Host))
+	}
+	if d.CustomDialContext != nil {
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return d.CustomDialContext(ctx, "tcp", addr)
+		}))
+	}
+	conn, err := grpc.DialContext(ctx, request.Address, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+
+	md := metadata.MD{}
+	for name, value := range request.Headers {
+		md.Append(name, value)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	if d.Debug {
+		fmt.Printf("Sending gRPC call %s to %s (authority: %s, metadata: %v)\n\n", request.FullyQualifiedMethod(), request.Address, request.Host, md)
+	}
+
+	var header metadata.MD
+	response := &structpb.Struct{}
+	err = conn.Invoke(ctx, request.FullyQualifiedMethod(), &structpb.Struct{}, response, grpc.Header(&header))
+
+	cRes := &CapturedResponse{Headers: header}
+	if err != nil {
+		s, ok := status.FromError(err)
+		if !ok {
+			return nil, nil, err
+		}
+		cRes.Code = s.Code()
+		cRes.Message = s.Message()
+		if d.Debug {
+			fmt.Printf("Received gRPC status %s: %s\n\n", s.Code(), s.Message())
+		}
+		return &CapturedRequest{}, cRes, nil
+	}
+
+	body, err := response.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+	if d.Debug {
+		fmt.Printf("Received gRPC response:\n%s\n\n", body)
+	}
+
+	cReq := &CapturedRequest{}
+	if err := json.Unmarshal(body, cReq); err != nil {
+		return nil, nil, fmt.Errorf("unexpected error reading response: %w", err)
+	}
+	return cReq, cRes, nil
+}
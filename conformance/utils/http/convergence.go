@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Convergence describes how AwaitConvergence converged.
+type Convergence struct {
+	// TimeToFirstSuccess is how long it took until the first successful
+	// attempt.
+	TimeToFirstSuccess time.Duration
+	// TimeToConsistency is how long it took until the threshold of
+	// successful attempts in a row was reached.
+	TimeToConsistency time.Duration
+	// Attempts is the number of attempts, successful or not.
+	Attempts int
+}
+
+var (
+	convergenceObserversLock sync.RWMutex
+	convergenceObservers     = map[string]func(Convergence){}
+)
+
+// ObserveConvergence calls observe with the Convergence of every call to
+// AwaitConvergence that succeeds in t or in any of its subtests, until t
+// completes.
+func ObserveConvergence(t *testing.T, observe func(Convergence)) {
+	name := t.Name()
+
+	convergenceObserversLock.Lock()
+	convergenceObservers[name] = observe
+	convergenceObserversLock.Unlock()
+
+	t.Cleanup(func() {
+		convergenceObserversLock.Lock()
+		delete(convergenceObservers, name)
+		convergenceObserversLock.Unlock()
+	})
+}
+
+// observeConvergence calls the observers of t and of the tests t is a subtest
+// of with c.
+func observeConvergence(t *testing.T, c Convergence) {
+	convergenceObserversLock.RLock()
+	defer convergenceObserversLock.RUnlock()
+
+	for name := t.Name(); ; {
+		if observe, ok := convergenceObservers[name]; ok {
+			observe(c)
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return
+		}
+		name = name[:i]
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"testing"
+	"time"
+)
+
+func TestObserveConvergence(t *testing.T) {
+	var observed []Convergence
+	t.Run("test", func(t *testing.T) {
+		ObserveConvergence(t, func(c Convergence) {
+			observed = append(observed, c)
+		})
+		t.Run("subtest", func(t *testing.T) {
+			AwaitConvergence(t, 3, time.Minute, func(time.Duration) bool { return true })
+		})
+		AwaitConvergence(t, 2, time.Minute, func(time.Duration) bool { return true })
+	})
+
+	// AwaitConvergence is not observed anymore once the test completed.
+	AwaitConvergence(t, 1, time.Minute, func(time.Duration) bool { return true })
+
+	if len(observed) != 2 {
+		t.Fatalf("Expected 2 observed convergences, got %d", len(observed))
+	}
+	for i, attempts := range []int{3, 2} {
+		if observed[i].Attempts != attempts {
+			t.Errorf("Expected %d attempts, got %d", attempts, observed[i].Attempts)
+		}
+		if observed[i].TimeToFirstSuccess > observed[i].TimeToConsistency {
+			t.Errorf("Expected the first success before consistency, got %v after %v", observed[i].TimeToFirstSuccess, observed[i].TimeToConsistency)
+		}
+	}
+}
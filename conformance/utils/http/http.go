@@ -68,7 +68,14 @@ type Request struct {
 	Path             string
 	Headers          map[string]string
 	UnfollowRedirect bool
-	Protocol         string
+
+	// Protocol is the protocol used to make the request. For requests sent
+	// to a Gateway this is a RoundTripper protocol such as
+	// roundtripper.H2CPriorKnowledgeProtocol, defaulting to HTTP; for mesh
+	// requests it is the URL scheme. When set on an ExpectedRequest, it is
+	// instead compared to the protocol seen by the backend, such as
+	// "HTTP/2.0".
+	Protocol string
 }
 
 // ExpectedRequest defines expected properties of a request that reaches a backend.
@@ -95,7 +102,11 @@ type Response struct {
 func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r roundtripper.RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
 	t.Helper()
 
-	req := MakeRequest(t, &expected, gwAddr, "HTTP", "http")
+	protocol := "HTTP"
+	if expected.Request.Protocol != "" {
+		protocol = expected.Request.Protocol
+	}
+	req := MakeRequest(t, &expected, gwAddr, protocol, "http")
 
 	WaitForConsistentResponse(t, r, req, expected, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency)
 }
@@ -171,11 +182,13 @@ func ipv6SafeHost(host string) string {
 }
 
 // AwaitConvergence runs the given function until it returns 'true' `threshold` times in a row.
-// Each failed attempt has a 1s delay; successful attempts have no delay.
+// Each failed attempt has a 1s delay; successful attempts have no delay. How it
+// converged is passed to the observers registered with ObserveConvergence.
 func AwaitConvergence(t *testing.T, threshold int, maxTimeToConsistency time.Duration, fn func(elapsed time.Duration) bool) {
 	successes := 0
 	attempts := 0
 	start := time.Now()
+	var timeToFirstSuccess time.Duration
 	to := time.After(maxTimeToConsistency)
 	delay := time.Second
 	for {
@@ -188,8 +201,16 @@ func AwaitConvergence(t *testing.T, threshold int, maxTimeToConsistency time.Dur
 		completed := fn(time.Now().Sub(start))
 		attempts++
 		if completed {
+			if timeToFirstSuccess == 0 {
+				timeToFirstSuccess = time.Since(start)
+			}
 			successes++
 			if successes >= threshold {
+				observeConvergence(t, Convergence{
+					TimeToFirstSuccess: timeToFirstSuccess,
+					TimeToConsistency:  time.Since(start),
+					Attempts:           attempts,
+				})
 				return
 			}
 			// Skip delay if we have a success
@@ -232,12 +253,15 @@ func CompareRequest(t *testing.T, req *roundtripper.Request, cReq *roundtripper.
 	if expected.Response.StatusCode != cRes.StatusCode {
 		return fmt.Errorf("expected status code to be %d, got %d", expected.Response.StatusCode, cRes.StatusCode)
 	}
-	if cRes.StatusCode == 200 {
+	if cRes.StatusCode == 200 || cRes.StatusCode == 101 {
 		// The request expected to arrive at the backend is
 		// the same as the request made, unless otherwise
-		// specified.
+		// specified. The protocol is only checked when it
+		// was explicitly specified, as the protocol used to
+		// make the request is not the one seen by the backend.
 		if expected.ExpectedRequest == nil {
 			expected.ExpectedRequest = &ExpectedRequest{Request: expected.Request}
+			expected.ExpectedRequest.Protocol = ""
 		}
 
 		if expected.ExpectedRequest.Method == "" {
@@ -254,6 +278,9 @@ func CompareRequest(t *testing.T, req *roundtripper.Request, cReq *roundtripper.
 		if expected.ExpectedRequest.Method != cReq.Method {
 			return fmt.Errorf("expected method to be %s, got %s", expected.ExpectedRequest.Method, cReq.Method)
 		}
+		if expected.ExpectedRequest.Protocol != "" && expected.ExpectedRequest.Protocol != cReq.Protocol {
+			return fmt.Errorf("expected protocol to be %s, got %s", expected.ExpectedRequest.Protocol, cReq.Protocol)
+		}
 		if expected.Namespace != cReq.Namespace {
 			return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cReq.Namespace)
 		}
@@ -50,6 +50,45 @@ type Applier struct {
 
 	// FS is the filesystem to use when reading manifests.
 	FS embed.FS
+
+	// NamespacePrefix, if set, is prepended to the names of all Namespaces and
+	// to every reference to them, including the values of the
+	// gateway-conformance label and of the selectors on it, so that several
+	// runs of the same manifests can share a cluster.
+	NamespacePrefix string
+
+	// Prefix, if set, is prepended to the names of all Namespaces and Gateways
+	// and to every reference to them, so that the same manifests can be
+	// applied several times side by side, e.g. once for each test running in
+	// parallel. Like NamespacePrefix, it is also prepended to the values of
+	// the gateway-conformance label and of the selectors on it.
+	Prefix string
+
+	// Namespaces are the names, in the manifests, of the Namespaces that
+	// listeners allowing routes from All Namespaces are restricted to when
+	// Prefix is set, so that they don't admit the routes of other prefixes.
+	Namespaces []string
+}
+
+// namespaceLabel is the label that the manifests select Namespaces with.
+const namespaceLabel = "gateway-conformance"
+
+// Namespace returns the name of the Namespace that the Applier creates for a
+// Namespace with the given name in the manifests.
+func (a Applier) Namespace(name string) string {
+	return a.Prefix + a.NamespacePrefix + name
+}
+
+// namespaceLabelValue returns the value of the gateway-conformance label that
+// the Applier uses for the given value in the manifests.
+func (a Applier) namespaceLabelValue(value string) string {
+	return a.Prefix + a.NamespacePrefix + value
+}
+
+// GatewayName returns the name of the Gateway that the Applier creates for a
+// Gateway with the given name in the manifests.
+func (a Applier) GatewayName(name string) string {
+	return a.Prefix + name
 }
 
 // prepareGateway adjusts the gatewayClassName.
@@ -84,6 +123,114 @@ func (a Applier) prepareNamespace(t *testing.T, uObj *unstructured.Unstructured)
 	require.NoErrorf(t, err, "error setting labels on Namespace %s", uObj.GetName())
 }
 
+// prefixNames prepends the prefixes to the names of Namespaces and Gateways,
+// and to the references to them in the spec of any resource.
+func (a Applier) prefixNames(uObj *unstructured.Unstructured) {
+	if namespace := uObj.GetNamespace(); namespace != "" {
+		uObj.SetNamespace(a.Namespace(namespace))
+	}
+
+	switch {
+	case uObj.GetKind() == "Namespace" && uObj.GetObjectKind().GroupVersionKind().Group == "":
+		uObj.SetName(a.Namespace(uObj.GetName()))
+		if labels := uObj.GetLabels(); labels[namespaceLabel] != "" {
+			labels[namespaceLabel] = a.namespaceLabelValue(labels[namespaceLabel])
+			uObj.SetLabels(labels)
+		}
+	case uObj.GetKind() == "Gateway":
+		uObj.SetName(a.GatewayName(uObj.GetName()))
+	}
+
+	if spec, ok := uObj.Object["spec"]; ok {
+		a.prefixReferences(spec)
+	}
+}
+
+// prefixReferences walks a resource's spec and prefixes every namespace
+// reference, including namespace selectors on the namespace name label and on
+// the gateway-conformance label, and the names of parent Gateways. When Prefix
+// is set, listeners that allow routes from All Namespaces are restricted to
+// the prefixed Namespaces.
+func (a Applier) prefixReferences(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v["from"] == "All" && a.Prefix != "" && len(a.Namespaces) > 0 {
+			names := make([]interface{}, 0, len(a.Namespaces))
+			for _, name := range a.Namespaces {
+				names = append(names, name)
+			}
+			v["from"] = "Selector"
+			v["selector"] = map[string]interface{}{
+				"matchExpressions": []interface{}{
+					map[string]interface{}{
+						"key":      "kubernetes.io/metadata.name",
+						"operator": "In",
+						"values":   names,
+					},
+				},
+			}
+		}
+		for key, field := range v {
+			switch key {
+			case "namespace", "kubernetes.io/metadata.name":
+				if name, ok := field.(string); ok {
+					v[key] = a.Namespace(name)
+					continue
+				}
+			case namespaceLabel:
+				if value, ok := field.(string); ok {
+					v[key] = a.namespaceLabelValue(value)
+					continue
+				}
+			case "values":
+				var prefix func(string) string
+				switch v["key"] {
+				case "kubernetes.io/metadata.name":
+					prefix = a.Namespace
+				case namespaceLabel:
+					prefix = a.namespaceLabelValue
+				}
+				if values, ok := field.([]interface{}); ok && prefix != nil {
+					for i := range values {
+						if value, ok := values[i].(string); ok {
+							values[i] = prefix(value)
+						}
+					}
+					continue
+				}
+			case "parentRefs":
+				if parentRefs, ok := field.([]interface{}); ok {
+					for _, parentRef := range parentRefs {
+						a.prefixParentRef(parentRef)
+					}
+				}
+			}
+			a.prefixReferences(field)
+		}
+	case []interface{}:
+		for _, item := range v {
+			a.prefixReferences(item)
+		}
+	}
+}
+
+// prefixParentRef prefixes the name of a parentRef that refers to a Gateway.
+func (a Applier) prefixParentRef(value interface{}) {
+	parentRef, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if kind, ok := parentRef["kind"]; ok && kind != "Gateway" {
+		return
+	}
+	if group, ok := parentRef["group"]; ok && group != "gateway.networking.k8s.io" {
+		return
+	}
+	if name, ok := parentRef["name"].(string); ok {
+		parentRef["name"] = a.GatewayName(name)
+	}
+}
+
 // prepareResources uses the options from an Applier to tweak resources given by
 // a set of manifests.
 func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder) ([]unstructured.Unstructured, error) {
@@ -112,6 +259,10 @@ func (a Applier) prepareResources(t *testing.T, decoder *yaml.YAMLOrJSONDecoder)
 			a.prepareNamespace(t, &uObj)
 		}
 
+		if a.Prefix != "" || a.NamespacePrefix != "" {
+			a.prefixNames(&uObj)
+		}
+
 		resources = append(resources, uObj)
 	}
 
@@ -21,7 +21,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	_ "sigs.k8s.io/gateway-api/conformance/utils/flags"
@@ -168,6 +171,274 @@ spec:
 				},
 			},
 		}},
+	}, {
+		name:    "prefixing namespaces",
+		applier: Applier{NamespacePrefix: "run-"},
+		given: `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: infra
+spec:
+  gatewayClassName: {GATEWAY_CLASS_NAME}
+  listeners:
+  - name: https
+    port: 443
+    protocol: HTTPS
+    tls:
+      certificateRefs:
+      - name: certificate
+        namespace: backend
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: ReferenceGrant
+metadata:
+  name: grant
+  namespace: backend
+spec:
+  from:
+  - group: gateway.networking.k8s.io
+    kind: Gateway
+    namespace: infra
+  to:
+  - group: ""
+    kind: Secret
+`,
+		expected: []unstructured.Unstructured{{
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "Gateway",
+				"metadata": map[string]interface{}{
+					"name":      "gateway",
+					"namespace": "run-infra",
+				},
+				"spec": map[string]interface{}{
+					"gatewayClassName": "test-class",
+					"listeners": []interface{}{
+						map[string]interface{}{
+							"name":     "https",
+							"port":     int64(443),
+							"protocol": "HTTPS",
+							"tls": map[string]interface{}{
+								"certificateRefs": []interface{}{
+									map[string]interface{}{
+										"name":      "certificate",
+										"namespace": "run-backend",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "ReferenceGrant",
+				"metadata": map[string]interface{}{
+					"name":      "grant",
+					"namespace": "run-backend",
+				},
+				"spec": map[string]interface{}{
+					"from": []interface{}{
+						map[string]interface{}{
+							"group":     "gateway.networking.k8s.io",
+							"kind":      "Gateway",
+							"namespace": "run-infra",
+						},
+					},
+					"to": []interface{}{
+						map[string]interface{}{
+							"group": "",
+							"kind":  "Secret",
+						},
+					},
+				},
+			},
+		}},
+	}, {
+		name:    "prefixing namespaces and gateways",
+		applier: Applier{Prefix: "test-", Namespaces: []string{"infra", "backend"}},
+		given: `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: infra
+  labels:
+    gateway-conformance: infra
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: infra
+spec:
+  gatewayClassName: {GATEWAY_CLASS_NAME}
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: Selector
+        selector:
+          matchLabels:
+            kubernetes.io/metadata.name: backend
+  - name: labeled
+    port: 8080
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: Selector
+        selector:
+          matchLabels:
+            gateway-conformance: backend
+          matchExpressions:
+          - key: gateway-conformance
+            operator: In
+            values:
+            - infra
+  - name: all
+    port: 8081
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: All
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: backend
+spec:
+  parentRefs:
+  - name: gateway
+    namespace: infra
+  - kind: Service
+    group: ""
+    name: mesh-service
+  rules:
+  - backendRefs:
+    - name: backend
+      namespace: infra
+      port: 8080
+`,
+		expected: []unstructured.Unstructured{{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Namespace",
+				"metadata": map[string]interface{}{
+					"name": "test-infra",
+					"labels": map[string]interface{}{
+						"gateway-conformance": "test-infra",
+					},
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "Gateway",
+				"metadata": map[string]interface{}{
+					"name":      "test-gateway",
+					"namespace": "test-infra",
+				},
+				"spec": map[string]interface{}{
+					"gatewayClassName": "test-class",
+					"listeners": []interface{}{
+						map[string]interface{}{
+							"name":     "http",
+							"port":     int64(80),
+							"protocol": "HTTP",
+							"allowedRoutes": map[string]interface{}{
+								"namespaces": map[string]interface{}{
+									"from": "Selector",
+									"selector": map[string]interface{}{
+										"matchLabels": map[string]interface{}{
+											"kubernetes.io/metadata.name": "test-backend",
+										},
+									},
+								},
+							},
+						},
+						map[string]interface{}{
+							"name":     "labeled",
+							"port":     int64(8080),
+							"protocol": "HTTP",
+							"allowedRoutes": map[string]interface{}{
+								"namespaces": map[string]interface{}{
+									"from": "Selector",
+									"selector": map[string]interface{}{
+										"matchLabels": map[string]interface{}{
+											"gateway-conformance": "test-backend",
+										},
+										"matchExpressions": []interface{}{
+											map[string]interface{}{
+												"key":      "gateway-conformance",
+												"operator": "In",
+												"values":   []interface{}{"test-infra"},
+											},
+										},
+									},
+								},
+							},
+						},
+						map[string]interface{}{
+							"name":     "all",
+							"port":     int64(8081),
+							"protocol": "HTTP",
+							"allowedRoutes": map[string]interface{}{
+								"namespaces": map[string]interface{}{
+									"from": "Selector",
+									"selector": map[string]interface{}{
+										"matchExpressions": []interface{}{
+											map[string]interface{}{
+												"key":      "kubernetes.io/metadata.name",
+												"operator": "In",
+												"values":   []interface{}{"test-infra", "test-backend"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}, {
+			Object: map[string]interface{}{
+				"apiVersion": "gateway.networking.k8s.io/v1beta1",
+				"kind":       "HTTPRoute",
+				"metadata": map[string]interface{}{
+					"name":      "route",
+					"namespace": "test-backend",
+				},
+				"spec": map[string]interface{}{
+					"parentRefs": []interface{}{
+						map[string]interface{}{
+							"name":      "test-gateway",
+							"namespace": "test-infra",
+						},
+						map[string]interface{}{
+							"kind":  "Service",
+							"group": "",
+							"name":  "mesh-service",
+						},
+					},
+					"rules": []interface{}{
+						map[string]interface{}{
+							"backendRefs": []interface{}{
+								map[string]interface{}{
+									"name":      "backend",
+									"namespace": "test-infra",
+									"port":      int64(8080),
+								},
+							},
+						},
+					},
+				},
+			},
+		}},
 	}}
 
 	for _, tc := range tests {
@@ -183,3 +454,56 @@ spec:
 		})
 	}
 }
+
+func TestNamespacePrefixSelectors(t *testing.T) {
+	manifests := `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: backend
+  labels:
+    gateway-conformance: backend
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: infra
+spec:
+  gatewayClassName: {GATEWAY_CLASS_NAME}
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: Selector
+        selector:
+          matchLabels:
+            gateway-conformance: backend
+`
+
+	prepare := func(prefix string) (labels.Set, labels.Selector) {
+		decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifests), 4096)
+		resources, err := Applier{NamespacePrefix: prefix}.prepareResources(t, decoder)
+		require.NoError(t, err, "unexpected error preparing resources")
+		require.Len(t, resources, 2)
+
+		listeners, _, err := unstructured.NestedSlice(resources[1].Object, "spec", "listeners")
+		require.NoError(t, err)
+		selectorField, _, err := unstructured.NestedMap(listeners[0].(map[string]interface{}), "allowedRoutes", "namespaces", "selector")
+		require.NoError(t, err)
+		var labelSelector metav1.LabelSelector
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(selectorField, &labelSelector))
+		selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+		require.NoError(t, err)
+		return labels.Set(resources[0].GetLabels()), selector
+	}
+
+	namespaceA, selectorA := prepare("a-")
+	namespaceB, selectorB := prepare("b-")
+	require.True(t, selectorA.Matches(namespaceA), "Gateway of prefix a- should select the Namespace of prefix a-")
+	require.True(t, selectorB.Matches(namespaceB), "Gateway of prefix b- should select the Namespace of prefix b-")
+	require.False(t, selectorA.Matches(namespaceB), "Gateway of prefix a- should not select the Namespace of prefix b-")
+	require.False(t, selectorB.Matches(namespaceA), "Gateway of prefix b- should not select the Namespace of prefix a-")
+}
@@ -30,7 +30,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +40,7 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/pkg/consts"
 )
 
 // GatewayRef is a tiny type for specifying an HTTP Route ParentRef without
@@ -239,6 +242,82 @@ func NamespacesMustBeReady(t *testing.T, c client.Client, timeoutConfig config.T
 	require.NoErrorf(t, waitErr, "error waiting for %s namespaces to be ready", strings.Join(namespaces, ", "))
 }
 
+// CustomResourceDefinitionMustBeEstablished waits until the named
+// CustomResourceDefinition has an Established condition set to True, so that
+// resources of that kind can be created. This will cause the test to halt if
+// the specified timeout is exceeded.
+func CustomResourceDefinitionMustBeEstablished(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, name string) {
+	t.Helper()
+
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.CreateTimeout, true, func(ctx context.Context) (bool, error) {
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+			t.Logf("Error fetching CustomResourceDefinition %s: %v", name, err)
+			return false, nil
+		}
+
+		conditions, _, err := unstructured.NestedSlice(crd.Object, "status", "conditions")
+		if err != nil {
+			return false, err
+		}
+		for _, cond := range conditions {
+			condMap, ok := cond.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if condMap["type"] == string(apiextensionsv1.Established) && condMap["status"] == string(apiextensionsv1.ConditionTrue) {
+				return true, nil
+			}
+		}
+		t.Logf("CustomResourceDefinition %s not Established yet", name)
+		return false, nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for CustomResourceDefinition %s to be established", name)
+}
+
+// BundleInfo describes the Gateway API CRDs installed in a cluster, as
+// recorded in their bundle version and channel annotations.
+type BundleInfo struct {
+	Version string
+	Channel string
+}
+
+// GetBundleInfo returns the bundle version and channel of the Gateway API
+// CRDs installed in the cluster. It returns an error if none are installed or
+// if they were not all installed from the same bundle.
+func GetBundleInfo(ctx context.Context, c client.Client) (BundleInfo, error) {
+	crds := &unstructured.UnstructuredList{}
+	crds.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinitionList"))
+	if err := c.List(ctx, crds); err != nil {
+		return BundleInfo{}, fmt.Errorf("error listing CustomResourceDefinitions: %w", err)
+	}
+
+	var info BundleInfo
+	var names []string
+	for _, crd := range crds.Items {
+		group, _, _ := unstructured.NestedString(crd.Object, "spec", "group")
+		if group != v1beta1.GroupName {
+			continue
+		}
+		annotations := crd.GetAnnotations()
+		crdInfo := BundleInfo{
+			Version: annotations[consts.BundleVersionAnnotation],
+			Channel: annotations[consts.ChannelAnnotation],
+		}
+		if len(names) > 0 && crdInfo != info {
+			return BundleInfo{}, fmt.Errorf("CustomResourceDefinition %s is from bundle %s (%s channel), but %s from bundle %s (%s channel)",
+				crd.GetName(), crdInfo.Version, crdInfo.Channel, strings.Join(names, ", "), info.Version, info.Channel)
+		}
+		info = crdInfo
+		names = append(names, crd.GetName())
+	}
+	if len(names) == 0 {
+		return BundleInfo{}, fmt.Errorf("no %s CustomResourceDefinitions installed", v1beta1.GroupName)
+	}
+	return info, nil
+}
+
 // GatewayAndHTTPRoutesMustBeAccepted waits until the specified Gateway has an IP
 // address assigned to it and the Route has a ParentRef referring to the
 // Gateway. The test will fail if these conditions are not met before the
@@ -648,6 +727,157 @@ func TLSRouteMustHaveCondition(t *testing.T, client client.Client, timeoutConfig
 	require.NoErrorf(t, waitErr, "error waiting for TLSRoute status to have a Condition matching expectations")
 }
 
+// GatewayAndRoutesMustBeAccepted waits until the specified Gateway has an IP
+// address assigned to it and each Route has a ParentRef referring to the
+// Gateway. The kind of the Routes is given by routeType, an empty Route of any
+// kind, e.g. &v1alpha2.TCPRoute{}. The test will fail if these conditions are
+// not met before the timeouts.
+func GatewayAndRoutesMustBeAccepted(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, controllerName string, gw GatewayRef, routeType client.Object, routeNNs ...types.NamespacedName) string {
+	t.Helper()
+
+	gwAddr, err := WaitForGatewayAddress(t, c, timeoutConfig, gw.NamespacedName)
+	require.NoErrorf(t, err, "timed out waiting for Gateway address to be assigned")
+
+	ns := v1beta1.Namespace(gw.Namespace)
+	kind := v1beta1.Kind("Gateway")
+
+	for _, routeNN := range routeNNs {
+		namespaceRequired := true
+		if routeNN.Namespace == gw.Namespace {
+			namespaceRequired = false
+		}
+
+		var parents []v1beta1.RouteParentStatus
+		for _, listener := range gw.listenerNames {
+			parents = append(parents, v1beta1.RouteParentStatus{
+				ParentRef: v1beta1.ParentReference{
+					Group:       (*v1beta1.Group)(&v1beta1.GroupVersion.Group),
+					Kind:        &kind,
+					Name:        v1beta1.ObjectName(gw.Name),
+					Namespace:   &ns,
+					SectionName: listener,
+				},
+				ControllerName: v1beta1.GatewayController(controllerName),
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(v1beta1.RouteConditionAccepted),
+						Status: metav1.ConditionTrue,
+						Reason: string(v1beta1.RouteReasonAccepted),
+					},
+				},
+			})
+		}
+		RouteMustHaveParents(t, c, timeoutConfig, routeType, routeNN, parents, namespaceRequired)
+	}
+
+	return gwAddr
+}
+
+// RouteMustHaveParents waits for the specified Route, of the kind given by
+// routeType, to have parents in status that match the expected parents. This
+// will cause the test to halt if the specified timeout is exceeded.
+func RouteMustHaveParents(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, routeType client.Object, routeName types.NamespacedName, parents []v1beta1.RouteParentStatus, namespaceRequired bool) {
+	t.Helper()
+
+	kind := routeKind(routeType)
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.RouteMustHaveParents, true, func(ctx context.Context) (bool, error) {
+		route := routeType.DeepCopyObject().(client.Object)
+		err := c.Get(ctx, routeName, route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching %s: %w", kind, err)
+		}
+
+		actual, err := routeParents(route)
+		if err != nil {
+			return false, err
+		}
+		for _, parent := range actual {
+			if err := ConditionsHaveLatestObservedGeneration(route, parent.Conditions); err != nil {
+				t.Logf("%s(controller=%v,ref=%#v) %v", kind, parent.ControllerName, parent, err)
+				return false, nil
+			}
+		}
+
+		return parentsForRouteMatch(t, routeName, parents, actual, namespaceRequired), nil
+	})
+	require.NoErrorf(t, waitErr, "error waiting for %s to have parents matching expectations", kind)
+}
+
+// RouteMustHaveCondition checks that the supplied Route, of the kind given by
+// routeType, has the supplied Condition, halting after the specified timeout
+// is exceeded.
+func RouteMustHaveCondition(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, routeType client.Object, routeNN types.NamespacedName, gwNN types.NamespacedName, condition metav1.Condition) {
+	t.Helper()
+
+	kind := routeKind(routeType)
+	waitErr := wait.PollUntilContextTimeout(context.Background(), 1*time.Second, timeoutConfig.RouteMustHaveCondition, true, func(ctx context.Context) (bool, error) {
+		route := routeType.DeepCopyObject().(client.Object)
+		err := c.Get(ctx, routeNN, route)
+		if err != nil {
+			return false, fmt.Errorf("error fetching %s: %w", kind, err)
+		}
+
+		parents, err := routeParents(route)
+		if err != nil {
+			return false, err
+		}
+		var conditionFound bool
+		for _, parent := range parents {
+			if err := ConditionsHaveLatestObservedGeneration(route, parent.Conditions); err != nil {
+				t.Logf("%s(parentRef=%v) %v", kind, parentRefToString(parent.ParentRef), err)
+				return false, nil
+			}
+
+			if parent.ParentRef.Name == v1beta1.ObjectName(gwNN.Name) && (parent.ParentRef.Namespace == nil || string(*parent.ParentRef.Namespace) == gwNN.Namespace) {
+				if findConditionInList(t, parent.Conditions, condition.Type, string(condition.Status), condition.Reason) {
+					conditionFound = true
+				}
+			}
+		}
+
+		return conditionFound, nil
+	})
+
+	require.NoErrorf(t, waitErr, "error waiting for %s status to have a Condition matching expectations", kind)
+}
+
+// RouteMustHaveResolvedRefsConditionsTrue checks that the supplied Route, of
+// the kind given by routeType, has the resolvedRefsCondition set to true.
+func RouteMustHaveResolvedRefsConditionsTrue(t *testing.T, c client.Client, timeoutConfig config.TimeoutConfig, routeType client.Object, routeNN types.NamespacedName, gwNN types.NamespacedName) {
+	RouteMustHaveCondition(t, c, timeoutConfig, routeType, routeNN, gwNN, metav1.Condition{
+		Type:   string(v1beta1.RouteConditionResolvedRefs),
+		Status: metav1.ConditionTrue,
+		Reason: string(v1beta1.RouteReasonResolvedRefs),
+	})
+}
+
+// routeParents returns the parents in the status of a Route of any kind.
+func routeParents(route client.Object) ([]v1beta1.RouteParentStatus, error) {
+	switch r := route.(type) {
+	case *v1beta1.HTTPRoute:
+		return r.Status.Parents, nil
+	case *v1beta1.GRPCRoute:
+		return r.Status.Parents, nil
+	case *v1alpha2.HTTPRoute:
+		return r.Status.Parents, nil
+	case *v1alpha2.GRPCRoute:
+		return r.Status.Parents, nil
+	case *v1alpha2.TLSRoute:
+		return r.Status.Parents, nil
+	case *v1alpha2.TCPRoute:
+		return r.Status.Parents, nil
+	case *v1alpha2.UDPRoute:
+		return r.Status.Parents, nil
+	default:
+		return nil, fmt.Errorf("unsupported Route type %T", route)
+	}
+}
+
+// routeKind returns the kind of a Route, for use in log and error messages.
+func routeKind(route client.Object) string {
+	return reflect.TypeOf(route).Elem().Name()
+}
+
 // TODO(mikemorris): this and parentsMatch could possibly be rewritten as a generic function?
 func listenersMatch(t *testing.T, expected, actual []v1beta1.ListenerStatus) bool {
 	t.Helper()
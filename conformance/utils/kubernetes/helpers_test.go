@@ -17,18 +17,26 @@ limitations under the License.
 package kubernetes
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/pkg/consts"
 )
 
 // -----------------------------------------------------------------------------
@@ -319,3 +327,99 @@ func Test_listenersMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBundleInfo(t *testing.T) {
+	crd := func(name, group, version, channel string) client.Object {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Annotations: map[string]string{
+					consts.BundleVersionAnnotation: version,
+					consts.ChannelAnnotation:       channel,
+				},
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{Group: group},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		objects []client.Object
+		want    BundleInfo
+		wantErr string
+	}{{
+		name: "consistent bundle",
+		objects: []client.Object{
+			crd("gateways.gateway.networking.k8s.io", v1beta1.GroupName, "v1.0.0", "standard"),
+			crd("httproutes.gateway.networking.k8s.io", v1beta1.GroupName, "v1.0.0", "standard"),
+			crd("widgets.example.com", "example.com", "v0.1.0", "other"),
+		},
+		want: BundleInfo{Version: "v1.0.0", Channel: "standard"},
+	}, {
+		name: "mixed bundles",
+		objects: []client.Object{
+			crd("gateways.gateway.networking.k8s.io", v1beta1.GroupName, "v1.0.0", "standard"),
+			crd("httproutes.gateway.networking.k8s.io", v1beta1.GroupName, "v1.0.0", "experimental"),
+		},
+		wantErr: "CustomResourceDefinition httproutes.gateway.networking.k8s.io is from bundle v1.0.0 (experimental channel)",
+	}, {
+		name:    "no CRDs",
+		wantErr: "no gateway.networking.k8s.io CustomResourceDefinitions installed",
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+
+			info, err := GetBundleInfo(context.Background(), c)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, info)
+		})
+	}
+}
+
+func TestRouteMustHaveCondition(t *testing.T) {
+	routeNN := types.NamespacedName{Name: "tcp-route", Namespace: "gateway-conformance-infra"}
+	gwNN := types.NamespacedName{Name: "gateway-tcproute", Namespace: "gateway-conformance-infra"}
+	routes := []client.Object{
+		&v1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: routeNN.Name, Namespace: routeNN.Namespace, Generation: 1},
+			Status: v1alpha2.TCPRouteStatus{RouteStatus: v1beta1.RouteStatus{
+				Parents: []v1beta1.RouteParentStatus{{
+					ParentRef:      v1beta1.ParentReference{Name: v1beta1.ObjectName(gwNN.Name)},
+					ControllerName: "example.com/gateway-controller",
+					Conditions: []metav1.Condition{{
+						Type:               string(v1beta1.RouteConditionResolvedRefs),
+						Status:             metav1.ConditionTrue,
+						Reason:             string(v1beta1.RouteReasonResolvedRefs),
+						ObservedGeneration: 1,
+					}},
+				}},
+			}},
+		},
+		&v1alpha2.UDPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "udp-route", Namespace: routeNN.Namespace},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(routes...).Build()
+
+	RouteMustHaveResolvedRefsConditionsTrue(t, c, config.TimeoutConfig{RouteMustHaveCondition: time.Second}, &v1alpha2.TCPRoute{}, routeNN, gwNN)
+
+	parents, err := routeParents(routes[1])
+	require.NoError(t, err)
+	assert.Empty(t, parents)
+	assert.Equal(t, "UDPRoute", routeKind(routes[1]))
+
+	_, err = routeParents(&v1beta1.Gateway{})
+	assert.EqualError(t, err, "unsupported Route type *v1beta1.Gateway")
+}
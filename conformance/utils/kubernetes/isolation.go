@@ -0,0 +1,132 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsolatedClient returns a client that reads any resource but refuses to
+// create, update, patch or delete resources outside of the Namespaces whose
+// names start with prefix. It guards the resources shared by all tests, such
+// as the GatewayClass and the base Gateways, against tests that run in
+// parallel with others.
+func IsolatedClient(c client.Client, prefix string) client.Client {
+	return &isolatedClient{Client: c, prefix: prefix}
+}
+
+type isolatedClient struct {
+	client.Client
+	prefix string
+}
+
+// checkIsolated returns an error if obj is not a Namespace, or a resource in a
+// Namespace, that starts with the prefix of the client.
+func (c *isolatedClient) checkIsolated(obj client.Object) error {
+	if strings.HasPrefix(obj.GetNamespace(), c.prefix) {
+		return nil
+	}
+
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return err
+	}
+	if obj.GetNamespace() == "" && gvk.Group == "" && gvk.Kind == "Namespace" && strings.HasPrefix(obj.GetName(), c.prefix) {
+		return nil
+	}
+
+	name := obj.GetName()
+	if obj.GetNamespace() != "" {
+		name = obj.GetNamespace() + "/" + name
+	}
+	return fmt.Errorf("%s %s is shared with other tests and can't be modified by a test isolated with prefix %q", gvk.Kind, name, c.prefix)
+}
+
+func (c *isolatedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *isolatedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *isolatedClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *isolatedClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+func (c *isolatedClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	deleteAllOfOptions := &client.DeleteAllOfOptions{}
+	deleteAllOfOptions.ApplyOptions(opts)
+	if !strings.HasPrefix(deleteAllOfOptions.Namespace, c.prefix) {
+		return fmt.Errorf("resources outside of the Namespaces with prefix %q can't be deleted by an isolated test", c.prefix)
+	}
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *isolatedClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *isolatedClient) SubResource(subResource string) client.SubResourceClient {
+	return &isolatedSubResourceClient{SubResourceClient: c.Client.SubResource(subResource), client: c}
+}
+
+type isolatedSubResourceClient struct {
+	client.SubResourceClient
+	client *isolatedClient
+}
+
+func (c *isolatedSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	if err := c.client.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.SubResourceClient.Create(ctx, obj, subResource, opts...)
+}
+
+func (c *isolatedSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	if err := c.client.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.SubResourceClient.Update(ctx, obj, opts...)
+}
+
+func (c *isolatedSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	if err := c.client.checkIsolated(obj); err != nil {
+		return err
+	}
+	return c.SubResourceClient.Patch(ctx, obj, patch, opts...)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+func TestIsolatedClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	sharedGateway := &v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "same-namespace", Namespace: "gateway-conformance-infra"}}
+	c := IsolatedClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(sharedGateway).WithStatusSubresource(&corev1.Namespace{}, &v1beta1.Gateway{}).Build(), "test-")
+	ctx := context.Background()
+
+	isolated := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-gateway-conformance-infra"}},
+		&v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "test-same-namespace", Namespace: "test-gateway-conformance-infra"}},
+	}
+	for _, obj := range isolated {
+		require.NoError(t, c.Create(ctx, obj))
+		require.NoError(t, c.Update(ctx, obj))
+		require.NoError(t, c.Status().Update(ctx, obj))
+		require.NoError(t, c.Delete(ctx, obj))
+	}
+
+	shared := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance-infra"}},
+		&v1beta1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "test-gateway-class"}},
+		sharedGateway,
+	}
+	for _, obj := range shared {
+		require.Error(t, c.Create(ctx, obj))
+		require.Error(t, c.Update(ctx, obj))
+		require.Error(t, c.Patch(ctx, obj, client.MergeFrom(obj)))
+		require.Error(t, c.Status().Update(ctx, obj))
+		require.Error(t, c.Delete(ctx, obj))
+	}
+	require.Error(t, c.DeleteAllOf(ctx, &v1beta1.Gateway{}, client.InNamespace("gateway-conformance-infra")))
+	require.NoError(t, c.DeleteAllOf(ctx, &v1beta1.Gateway{}, client.InNamespace("test-gateway-conformance-infra")))
+
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(sharedGateway), &v1beta1.Gateway{}))
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+)
+
+// ExpectedResponse defines the response expected for a given payload.
+type ExpectedResponse struct {
+	// Request defines the payload to send. Request.Address is set to the
+	// Gateway address.
+	Request Request
+
+	Backend   string
+	Namespace string
+
+	// User Given TestCase name
+	TestCaseName string
+}
+
+// MakeRequestAndExpectEventuallyConsistentResponse sends a payload with the
+// given parameters, understanding that the round trip may fail for some
+// amount of time.
+//
+// Once the round trip succeeds consistently, make additional assertions on
+// the response using the provided ExpectedResponse.
+func MakeRequestAndExpectEventuallyConsistentResponse(t *testing.T, r RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, expected ExpectedResponse) {
+	t.Helper()
+
+	req := expected.Request
+	req.Address = gwAddr
+
+	t.Logf("Sending %s payload %q to %s", req.Protocol, req.Payload, gwAddr)
+
+	http.AwaitConvergence(t, timeoutConfig.RequiredConsecutiveSuccesses, timeoutConfig.MaxTimeToConsistency, func(elapsed time.Duration) bool {
+		cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			t.Logf("%s round trip failed, not ready yet: %v (after %v)", req.Protocol, err.Error(), elapsed)
+			return false
+		}
+
+		if err := CompareResponse(cRes, expected); err != nil {
+			t.Logf("Response expectation failed for %s payload %q: not ready yet: %v (after %v)", req.Protocol, req.Payload, err, elapsed)
+			return false
+		}
+
+		return true
+	})
+	t.Logf("%s round trip passed", req.Protocol)
+}
+
+// MakeRequestsAndExpectBackends repeatedly sends the payload of the request
+// until every one of the expected backends, all in the given namespace, has
+// answered, understanding that responses may come from other backends for
+// some amount of time. A response from any other backend is treated as not
+// ready yet and starts the count over. The test fails if not all backends
+// have answered since the last such response within the
+// MaxTimeToConsistency timeout.
+func MakeRequestsAndExpectBackends(t *testing.T, r RoundTripper, timeoutConfig config.TimeoutConfig, gwAddr string, req Request, namespace string, backends ...string) {
+	t.Helper()
+
+	req.Address = gwAddr
+	seen := map[string]bool{}
+	start := time.Now()
+	for len(seen) < len(backends) {
+		elapsed := time.Since(start)
+		if elapsed > timeoutConfig.MaxTimeToConsistency {
+			t.Fatalf("timed out waiting for responses from all of %v after %v, only got responses from %v", backends, elapsed, seen)
+		}
+
+		cRes, err := r.CaptureRoundTrip(req)
+		if err != nil {
+			t.Logf("%s round trip failed, not ready yet: %v (after %v)", req.Protocol, err, elapsed)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		backend := ""
+		for _, b := range backends {
+			if strings.HasPrefix(cRes.Pod, b) {
+				backend = b
+			}
+		}
+		if backend == "" || cRes.Namespace != namespace {
+			t.Logf("%s round trip reached pod %s in namespace %s instead of one of %v in namespace %s, not ready yet (after %v)", req.Protocol, cRes.Pod, cRes.Namespace, backends, namespace, elapsed)
+			seen = map[string]bool{}
+			time.Sleep(time.Second)
+			continue
+		}
+		seen[backend] = true
+	}
+	t.Logf("%s round trips reached all of %v", req.Protocol, backends)
+}
+
+// CompareResponse compares the response echoed by the backend to expected.
+func CompareResponse(cRes *CapturedResponse, expected ExpectedResponse) error {
+	if expected.Request.Protocol != cRes.Protocol {
+		return fmt.Errorf("expected backend to receive the payload over %s, got %s", expected.Request.Protocol, cRes.Protocol)
+	}
+	if expected.Request.Payload != cRes.Payload {
+		return fmt.Errorf("expected payload to be %q, got %q", expected.Request.Payload, cRes.Payload)
+	}
+	if expected.Namespace != cRes.Namespace {
+		return fmt.Errorf("expected namespace to be %s, got %s", expected.Namespace, cRes.Namespace)
+	}
+	if !strings.HasPrefix(cRes.Pod, expected.Backend) {
+		return fmt.Errorf("expected pod name to start with %s, got %s", expected.Backend, cRes.Pod)
+	}
+	return nil
+}
+
+// GetTestCaseName gets the user-defined test case name or generates one from
+// the expected response to a given payload.
+func (er *ExpectedResponse) GetTestCaseName(i int) string {
+	if er.TestCaseName != "" {
+		return er.TestCaseName
+	}
+
+	reqStr := fmt.Sprintf("%d %s payload", i, er.Request.Protocol)
+	if er.Backend != "" {
+		return fmt.Sprintf("%s should go to %s", reqStr, er.Backend)
+	}
+	return fmt.Sprintf("%s should receive a response", reqStr)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4
+
+import (
+	"sync"
+	"time"
+)
+
+// RoundTrip is a round trip recorded by a RecordingRoundTripper.
+type RoundTrip struct {
+	Time     time.Time         `json:"time"`
+	Request  Request           `json:"request"`
+	Response *CapturedResponse `json:"response,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// RecordingRoundTripper is a RoundTripper that records the most recent round
+// trips made through it, e.g. to show them when a test fails.
+type RecordingRoundTripper struct {
+	RoundTripper RoundTripper
+
+	lock       sync.Mutex
+	size       int
+	roundTrips []RoundTrip
+}
+
+// NewRecordingRoundTripper returns a RecordingRoundTripper that makes round
+// trips with roundTripper and records the last size of them.
+func NewRecordingRoundTripper(roundTripper RoundTripper, size int) *RecordingRoundTripper {
+	return &RecordingRoundTripper{RoundTripper: roundTripper, size: size}
+}
+
+// CaptureRoundTrip makes the round trip with the wrapped RoundTripper and
+// records it.
+func (r *RecordingRoundTripper) CaptureRoundTrip(request Request) (*CapturedResponse, error) {
+	cRes, err := r.RoundTripper.CaptureRoundTrip(request)
+
+	roundTrip := RoundTrip{
+		Time:     time.Now(),
+		Request:  request,
+		Response: cRes,
+	}
+	if err != nil {
+		roundTrip.Error = err.Error()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.roundTrips = append(r.roundTrips, roundTrip)
+	if len(r.roundTrips) > r.size {
+		r.roundTrips = r.roundTrips[len(r.roundTrips)-r.size:]
+	}
+
+	return cRes, err
+}
+
+// RoundTrips returns the recorded round trips, oldest first.
+func (r *RecordingRoundTripper) RoundTrips() []RoundTrip {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return append([]RoundTrip(nil), r.roundTrips...)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package l4
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
+)
+
+// maxDatagramSize is the largest UDP response the DefaultRoundTripper reads.
+const maxDatagramSize = 65507
+
+// Protocol is the transport protocol used to reach a Gateway listener.
+type Protocol string
+
+const (
+	ProtocolTCP Protocol = "TCP"
+	ProtocolUDP Protocol = "UDP"
+)
+
+// RoundTripper is an interface used to send payloads over TCP or UDP within
+// conformance tests. This can be overridden with custom implementations
+// whenever necessary.
+type RoundTripper interface {
+	CaptureRoundTrip(Request) (*CapturedResponse, error)
+}
+
+// Request is the primary input for a Layer 4 round trip.
+type Request struct {
+	// Protocol is the transport protocol to use, either TCP or UDP.
+	Protocol Protocol
+	// Address is the host:port to connect to, usually the Gateway address.
+	Address string
+	// Payload is sent as a single line over TCP, or as a single datagram
+	// over UDP. It must not contain a newline.
+	Payload string
+}
+
+// CapturedResponse contains the response echoed by an echo-basic backend,
+// identifying which backend received the payload.
+type CapturedResponse struct {
+	Protocol Protocol `json:"protocol"`
+	Payload  string   `json:"payload"`
+
+	Namespace string `json:"namespace"`
+	Service   string `json:"service"`
+	Pod       string `json:"pod"`
+}
+
+// DefaultRoundTripper is the default implementation of a Layer 4
+// RoundTripper. Every round trip uses a new connection, so that consecutive
+// round trips may be balanced across backends.
+type DefaultRoundTripper struct {
+	Debug             bool
+	TimeoutConfig     config.TimeoutConfig
+	CustomDialContext func(context.Context, string, string) (net.Conn, error)
+}
+
+// CaptureRoundTrip sends the payload of the request and returns the response
+// echoed by the backend that received it.
+func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedResponse, error) {
+	if strings.Contains(request.Payload, "\n") {
+		return nil, fmt.Errorf("payload must not contain a newline")
+	}
+
+	var network string
+	switch request.Protocol {
+	case ProtocolTCP:
+		network = "tcp"
+	case ProtocolUDP:
+		network = "udp"
+	default:
+		return nil, fmt.Errorf("unsupported protocol %q", request.Protocol)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	dialContext := (&net.Dialer{}).DialContext
+	if d.CustomDialContext != nil {
+		dialContext = d.CustomDialContext
+	}
+	conn, err := dialContext(ctx, network, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	if d.Debug {
+		fmt.Printf("Sending %s payload %q to %s\n\n", request.Protocol, request.Payload, request.Address)
+	}
+
+	var body []byte
+	if request.Protocol == ProtocolTCP {
+		if _, err := conn.Write([]byte(request.Payload + "\n")); err != nil {
+			return nil, err
+		}
+		body, err = bufio.NewReader(conn).ReadBytes('\n')
+	} else {
+		if _, err := conn.Write([]byte(request.Payload)); err != nil {
+			return nil, err
+		}
+		buf := make([]byte, maxDatagramSize)
+		var n int
+		n, err = conn.Read(buf)
+		body = buf[:n]
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s response: %w", request.Protocol, err)
+	}
+
+	if d.Debug {
+		fmt.Printf("Received %s response:\n%s\n\n", request.Protocol, body)
+	}
+
+	cRes := &CapturedResponse{}
+	if err := json.Unmarshal(body, cRes); err != nil {
+		return nil, fmt.Errorf("unexpected error reading response: %w", err)
+	}
+	return cRes, nil
+}
@@ -28,10 +28,26 @@ import (
 	"net/http/httputil"
 	"net/url"
 	"regexp"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/websocket"
 
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
+const (
+	// H2CPriorKnowledgeProtocol is the Request Protocol used to send
+	// requests over HTTP/2 cleartext (h2c) with prior knowledge, i.e.
+	// without an HTTP/1.1 upgrade.
+	H2CPriorKnowledgeProtocol = "H2C_PRIOR_KNOWLEDGE"
+
+	// WebSocketProtocol is the Request Protocol used to send requests as an
+	// HTTP/1.1 WebSocket upgrade. The echo backend answers with the captured
+	// upgrade request as the first message on the WebSocket connection.
+	WebSocketProtocol = "WEBSOCKET"
+)
+
 // RoundTripper is an interface used to make requests within conformance tests.
 // This can be overridden with custom implementations whenever necessary.
 type RoundTripper interface {
@@ -109,6 +125,10 @@ type DefaultRoundTripper struct {
 // there is an error running the function but not if an HTTP error status code
 // is received.
 func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	if request.Protocol == WebSocketProtocol {
+		return d.captureWebSocketRoundTrip(request)
+	}
+
 	client := &http.Client{}
 
 	if request.UnfollowRedirect {
@@ -117,15 +137,9 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 		}
 	}
 
-	transport := &http.Transport{
-		DialContext: d.CustomDialContext,
-	}
-	if request.Server != "" && len(request.CertPem) != 0 && len(request.KeyPem) != 0 {
-		tlsConfig, err := tlsClientConfig(request.Server, request.CertPem, request.KeyPem)
-		if err != nil {
-			return nil, nil, err
-		}
-		transport.TLSClientConfig = tlsConfig
+	transport, err := d.transport(request)
+	if err != nil {
+		return nil, nil, err
 	}
 	client.Transport = transport
 
@@ -214,6 +228,114 @@ func (d *DefaultRoundTripper) CaptureRoundTrip(request Request) (*CapturedReques
 	return cReq, cRes, nil
 }
 
+// transport returns the http.RoundTripper to use for the given request.
+func (d *DefaultRoundTripper) transport(request Request) (http.RoundTripper, error) {
+	hasTLSConfig := request.Server != "" && len(request.CertPem) != 0 && len(request.KeyPem) != 0
+
+	if request.Protocol == H2CPriorKnowledgeProtocol {
+		if hasTLSConfig {
+			return nil, fmt.Errorf("request has a TLS configuration but %s is not encrypted", H2CPriorKnowledgeProtocol)
+		}
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return d.dialContext(ctx, network, addr)
+			},
+		}, nil
+	}
+
+	transport := &http.Transport{
+		DialContext: d.CustomDialContext,
+	}
+	if hasTLSConfig {
+		tlsConfig, err := tlsClientConfig(request.Server, request.CertPem, request.KeyPem)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	return transport, nil
+}
+
+// captureWebSocketRoundTrip performs a WebSocket upgrade with the provided
+// parameters and returns the request captured by the echo backend, which it
+// sends as the first message once the connection is established.
+func (d *DefaultRoundTripper) captureWebSocketRoundTrip(request Request) (*CapturedRequest, *CapturedResponse, error) {
+	location := request.URL
+	location.Scheme = "ws"
+	if request.Host != "" {
+		location.Host = request.Host
+	}
+	origin := url.URL{Scheme: "http", Host: location.Host}
+
+	wsConfig, err := websocket.NewConfig(location.String(), origin.String())
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, values := range request.Headers {
+		for _, value := range values {
+			wsConfig.Header.Add(name, value)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.TimeoutConfig.RequestTimeout)
+	defer cancel()
+
+	addr := request.URL.Host
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, "80")
+	}
+	conn, err := d.dialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	if d.Debug {
+		fmt.Printf("Sending WebSocket upgrade request to %s (Host: %s)\n\n", addr, location.Host)
+	}
+
+	ws, err := websocket.NewClient(wsConfig, conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("websocket upgrade failed: %w", err)
+	}
+	defer ws.Close()
+
+	var body []byte
+	if err := websocket.Message.Receive(ws, &body); err != nil {
+		return nil, nil, fmt.Errorf("unexpected error reading websocket message: %w", err)
+	}
+
+	if d.Debug {
+		fmt.Printf("Received WebSocket message:\n%s\n\n", formatDump(body, "< "))
+	}
+
+	cReq := &CapturedRequest{}
+	if err := json.Unmarshal(body, cReq); err != nil {
+		return nil, nil, fmt.Errorf("unexpected error reading websocket message: %w", err)
+	}
+
+	cRes := &CapturedResponse{
+		StatusCode: http.StatusSwitchingProtocols,
+		Protocol:   "HTTP/1.1",
+	}
+
+	return cReq, cRes, nil
+}
+
+func (d *DefaultRoundTripper) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if d.CustomDialContext != nil {
+		return d.CustomDialContext(ctx, network, addr)
+	}
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	return dialer.DialContext(ctx, network, addr)
+}
+
 func tlsClientConfig(server string, certPem []byte, keyPem []byte) (*tls.Config, error) {
 	// Create a certificate from the provided cert and key
 	cert, err := tls.X509KeyPair(certPem, keyPem)
@@ -0,0 +1,291 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+const (
+	// ResourcesArtifact is the file with the Gateway API resources in the
+	// test Namespaces, and the GatewayClass, when a test failed.
+	ResourcesArtifact = "resources.yaml"
+	// EventsArtifact is the file with the events in the test Namespaces.
+	EventsArtifact = "events.yaml"
+	// RoundTripsArtifact is the file with the last round trips the test made
+	// with the RoundTripper.
+	RoundTripsArtifact = "roundtrips.json"
+	// GRPCRoundTripsArtifact is the file with the last calls the test made
+	// with the GRPCRoundTripper.
+	GRPCRoundTripsArtifact = "grpcroundtrips.json"
+	// L4RoundTripsArtifact is the file with the last round trips the test
+	// made with the L4RoundTripper.
+	L4RoundTripsArtifact = "l4roundtrips.json"
+	// LogsArtifactsDir is the directory with the logs of every Pod in the
+	// test Namespaces, in a file per container named
+	// <namespace>/<pod>/<container>.log.
+	LogsArtifactsDir = "logs"
+
+	// recordedRoundTrips is the number of the most recent round trips of a
+	// test that are written to its artifacts.
+	recordedRoundTrips = 20
+)
+
+// gatewayAPIListKinds are the kinds of Gateway API resources written to the
+// artifacts of failed tests.
+var gatewayAPIListKinds = []schema.GroupVersionKind{
+	v1beta1.SchemeGroupVersion.WithKind("GatewayList"),
+	v1beta1.SchemeGroupVersion.WithKind("HTTPRouteList"),
+	v1beta1.SchemeGroupVersion.WithKind("GRPCRouteList"),
+	v1beta1.SchemeGroupVersion.WithKind("ReferenceGrantList"),
+	v1alpha2.SchemeGroupVersion.WithKind("TLSRouteList"),
+	v1alpha2.SchemeGroupVersion.WithKind("TCPRouteList"),
+	v1alpha2.SchemeGroupVersion.WithKind("UDPRouteList"),
+}
+
+// recordRoundTrips returns a copy of the suite that records the round trips
+// made with its RoundTripper, GRPCRoundTripper and L4RoundTripper, to write
+// them to the artifacts of the test.
+func (suite *ConformanceTestSuite) recordRoundTrips() *ConformanceTestSuite {
+	recording := *suite
+	recording.roundTrips = roundtripper.NewRecordingRoundTripper(suite.RoundTripper, recordedRoundTrips)
+	recording.RoundTripper = recording.roundTrips
+	recording.grpcRoundTrips = grpc.NewRecordingRoundTripper(suite.GRPCRoundTripper, recordedRoundTrips)
+	recording.GRPCRoundTripper = recording.grpcRoundTrips
+	recording.l4RoundTrips = l4.NewRecordingRoundTripper(suite.L4RoundTripper, recordedRoundTrips)
+	recording.L4RoundTripper = recording.l4RoundTrips
+	return &recording
+}
+
+// writeArtifactsOnFailure writes the artifacts of the test to a directory
+// named after it in ArtifactsDir if it fails. It must be called after the
+// manifests of the test are applied so that the artifacts are written before
+// they are cleaned up.
+func (suite *ConformanceTestSuite) writeArtifactsOnFailure(t *testing.T) {
+	t.Cleanup(func() {
+		if !t.Failed() {
+			return
+		}
+		dir := filepath.Join(suite.ArtifactsDir, filepath.FromSlash(t.Name()))
+		t.Logf("Writing artifacts of the failed test to %s", dir)
+		for _, err := range suite.writeArtifacts(dir) {
+			t.Logf("Error writing artifacts: %v", err)
+		}
+	})
+}
+
+// writeArtifacts writes the Gateway API resources, the events and the Pod logs
+// in the test Namespaces, and the last round trips of the test, to dir. It
+// writes as many of them as it can, and returns the errors for the others.
+func (suite *ConformanceTestSuite) writeArtifacts(dir string) []error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	namespaces := suite.testNamespaces()
+	if err := suite.writeResources(filepath.Join(dir, ResourcesArtifact), namespaces); err != nil {
+		errs = append(errs, err)
+	}
+	if err := suite.writeEvents(filepath.Join(dir, EventsArtifact), namespaces); err != nil {
+		errs = append(errs, err)
+	}
+	errs = append(errs, suite.writeLogs(filepath.Join(dir, LogsArtifactsDir), namespaces)...)
+	if suite.roundTrips != nil {
+		if err := writeJSON(filepath.Join(dir, RoundTripsArtifact), suite.roundTrips.RoundTrips()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// Most tests make no gRPC or L4 round trips, so these are only written
+	// when there are some.
+	if suite.grpcRoundTrips != nil {
+		if roundTrips := suite.grpcRoundTrips.RoundTrips(); len(roundTrips) > 0 {
+			if err := writeJSON(filepath.Join(dir, GRPCRoundTripsArtifact), roundTrips); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if suite.l4RoundTrips != nil {
+		if roundTrips := suite.l4RoundTrips.RoundTrips(); len(roundTrips) > 0 {
+			if err := writeJSON(filepath.Join(dir, L4RoundTripsArtifact), roundTrips); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// testNamespaces returns the Namespaces of the base resources for the
+// features supported by the suite.
+func (suite *ConformanceTestSuite) testNamespaces() []string {
+	namespaces := sets.New[string]()
+	if suite.SupportedFeatures.Has(SupportGateway) {
+		namespaces.Insert(suite.InfraNamespace(), suite.AppBackendNamespace(), suite.WebBackendNamespace())
+	}
+	if suite.SupportedFeatures.Has(SupportMesh) {
+		namespaces.Insert(suite.MeshNamespace(), suite.MeshConsumerNamespace(), suite.AppBackendNamespace(), suite.WebBackendNamespace())
+	}
+	return sets.List(namespaces)
+}
+
+// writeResources writes the GatewayClass of the suite and the Gateway API
+// resources in the namespaces to path as a YAML stream. Kinds whose CRDs are
+// not installed are left out.
+func (suite *ConformanceTestSuite) writeResources(path string, namespaces []string) error {
+	var objects []unstructured.Unstructured
+
+	if suite.GatewayClassName != "" {
+		gwc := &unstructured.Unstructured{}
+		gwc.SetGroupVersionKind(v1beta1.SchemeGroupVersion.WithKind("GatewayClass"))
+		err := suite.Client.Get(context.TODO(), client.ObjectKey{Name: suite.GatewayClassName}, gwc)
+		if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return fmt.Errorf("error getting GatewayClass %s: %w", suite.GatewayClassName, err)
+		}
+		if err == nil {
+			objects = append(objects, *gwc)
+		}
+	}
+
+	for _, gvk := range gatewayAPIListKinds {
+		for _, ns := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+			if err := suite.Client.List(context.TODO(), list, client.InNamespace(ns)); err != nil {
+				if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+					break
+				}
+				return fmt.Errorf("error listing %s in %s: %w", gvk.Kind, ns, err)
+			}
+			objects = append(objects, list.Items...)
+		}
+	}
+
+	return writeYAML(path, objects)
+}
+
+// writeEvents writes the events in the namespaces to path as a YAML stream.
+func (suite *ConformanceTestSuite) writeEvents(path string, namespaces []string) error {
+	var objects []unstructured.Unstructured
+	for _, ns := range namespaces {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("EventList"))
+		if err := suite.Client.List(context.TODO(), list, client.InNamespace(ns)); err != nil {
+			return fmt.Errorf("error listing events in %s: %w", ns, err)
+		}
+		objects = append(objects, list.Items...)
+	}
+	return writeYAML(path, objects)
+}
+
+// writeLogs writes the logs of every container of the Pods in the namespaces
+// to dir.
+func (suite *ConformanceTestSuite) writeLogs(dir string, namespaces []string) []error {
+	if suite.Clientset == nil {
+		return []error{fmt.Errorf("no clientset to get Pod logs with")}
+	}
+
+	var errs []error
+	for _, ns := range namespaces {
+		pods := &corev1.PodList{}
+		if err := suite.Client.List(context.TODO(), pods, client.InNamespace(ns)); err != nil {
+			errs = append(errs, fmt.Errorf("error listing Pods in %s: %w", ns, err))
+			continue
+		}
+		for _, pod := range pods.Items {
+			for _, container := range pod.Spec.Containers {
+				path := filepath.Join(dir, ns, pod.Name, container.Name+".log")
+				if err := suite.writeLog(path, pod, container.Name); err != nil {
+					errs = append(errs, fmt.Errorf("error getting logs of %s/%s container %s: %w", ns, pod.Name, container.Name, err))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// writeLog writes the logs of a container of the Pod to path.
+func (suite *ConformanceTestSuite) writeLog(path string, pod corev1.Pod, container string) error {
+	req := suite.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{Container: container})
+	logStream, err := req.Stream(context.TODO())
+	if err != nil {
+		return err
+	}
+	defer logStream.Close()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, logStream)
+	return err
+}
+
+// writeYAML writes the objects to path as a YAML stream, without their
+// managed fields.
+func writeYAML(path string, objects []unstructured.Unstructured) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for _, obj := range objects {
+		obj.SetManagedFields(nil)
+		out, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(f, "---\n%s", out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJSON writes v to path as indented JSON.
+func writeJSON(path string, v interface{}) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(out, '\n'), 0o644)
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
+	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+)
+
+type fakeRoundTripper struct {
+	calls int
+}
+
+func (f *fakeRoundTripper) CaptureRoundTrip(request roundtripper.Request) (*roundtripper.CapturedRequest, *roundtripper.CapturedResponse, error) {
+	f.calls++
+	if f.calls%2 == 0 {
+		return nil, nil, errors.New("connection refused")
+	}
+	return &roundtripper.CapturedRequest{Path: request.URL.Path}, &roundtripper.CapturedResponse{StatusCode: 200}, nil
+}
+
+type fakeGRPCRoundTripper struct{}
+
+func (fakeGRPCRoundTripper) CaptureRoundTrip(request grpc.Request) (*grpc.CapturedRequest, *grpc.CapturedResponse, error) {
+	return &grpc.CapturedRequest{FullyQualifiedMethod: request.FullyQualifiedMethod()}, &grpc.CapturedResponse{Code: codes.OK}, nil
+}
+
+type fakeL4RoundTripper struct{}
+
+func (fakeL4RoundTripper) CaptureRoundTrip(request l4.Request) (*l4.CapturedResponse, error) {
+	return nil, errors.New("connection reset")
+}
+
+func TestWriteArtifacts(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta1.AddToScheme(scheme))
+	require.NoError(t, v1alpha2.AddToScheme(scheme))
+
+	infra := "gateway-conformance-infra"
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1beta1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "gateway-conformance"}},
+		&v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "same-namespace", Namespace: infra}},
+		&v1beta1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "route", Namespace: infra}},
+		&v1beta1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"}},
+		&corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "event", Namespace: infra}, Reason: "Programmed"},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra-backend-v1", Namespace: infra},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "infra-backend-v1"}}},
+		},
+	).Build()
+
+	suite := New(Options{
+		Client:           c,
+		Clientset:        fakeclientset.NewSimpleClientset(),
+		GatewayClassName: "gateway-conformance",
+		ArtifactsDir:     t.TempDir(),
+	}).recordRoundTrips()
+
+	rt := &fakeRoundTripper{}
+	suite.roundTrips.RoundTripper = rt
+	for i := 0; i < recordedRoundTrips+2; i++ {
+		_, _, _ = suite.RoundTripper.CaptureRoundTrip(roundtripper.Request{CertPem: []byte("cert"), KeyPem: []byte("key")})
+	}
+
+	dir := filepath.Join(suite.ArtifactsDir, "TestConformance", "Test")
+	require.Empty(t, suite.writeArtifacts(dir))
+	assert.NoFileExists(t, filepath.Join(dir, GRPCRoundTripsArtifact))
+	assert.NoFileExists(t, filepath.Join(dir, L4RoundTripsArtifact))
+
+	suite.grpcRoundTrips.RoundTripper = fakeGRPCRoundTripper{}
+	_, _, _ = suite.GRPCRoundTripper.CaptureRoundTrip(grpc.Request{Service: "grpcecho.GrpcEcho", Method: "Echo"})
+	suite.l4RoundTrips.RoundTripper = fakeL4RoundTripper{}
+	_, _ = suite.L4RoundTripper.CaptureRoundTrip(l4.Request{Protocol: l4.ProtocolTCP, Payload: "ping"})
+	require.Empty(t, suite.writeArtifacts(dir))
+
+	resources, err := os.ReadFile(filepath.Join(dir, ResourcesArtifact))
+	require.NoError(t, err)
+	assert.Contains(t, string(resources), "kind: GatewayClass")
+	assert.Contains(t, string(resources), "name: same-namespace")
+	assert.Contains(t, string(resources), "name: route")
+	assert.NotContains(t, string(resources), "name: other")
+
+	events, err := os.ReadFile(filepath.Join(dir, EventsArtifact))
+	require.NoError(t, err)
+	assert.Contains(t, string(events), "reason: Programmed")
+
+	logs, err := os.ReadFile(filepath.Join(dir, LogsArtifactsDir, infra, "infra-backend-v1", "infra-backend-v1.log"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, logs)
+
+	out, err := os.ReadFile(filepath.Join(dir, RoundTripsArtifact))
+	require.NoError(t, err)
+	var roundTrips []roundtripper.RoundTrip
+	require.NoError(t, json.Unmarshal(out, &roundTrips))
+	require.Len(t, roundTrips, recordedRoundTrips)
+	assert.Equal(t, "connection refused", roundTrips[len(roundTrips)-1].Error)
+	assert.False(t, strings.Contains(string(out), "Y2VydA=="), "certificates must not be recorded")
+
+	out, err = os.ReadFile(filepath.Join(dir, GRPCRoundTripsArtifact))
+	require.NoError(t, err)
+	var grpcRoundTrips []grpc.RoundTrip
+	require.NoError(t, json.Unmarshal(out, &grpcRoundTrips))
+	require.Len(t, grpcRoundTrips, 1)
+	assert.Equal(t, "/grpcecho.GrpcEcho/Echo", grpcRoundTrips[0].Captured.FullyQualifiedMethod)
+
+	out, err = os.ReadFile(filepath.Join(dir, L4RoundTripsArtifact))
+	require.NoError(t, err)
+	var l4RoundTrips []l4.RoundTrip
+	require.NoError(t, json.Unmarshal(out, &l4RoundTrips))
+	require.Len(t, l4RoundTrips, 1)
+	assert.Equal(t, "ping", l4RoundTrips[0].Request.Payload)
+	assert.Equal(t, "connection reset", l4RoundTrips[0].Error)
+}
+
+func TestTestNamespaces(t *testing.T) {
+	suite := &ConformanceTestSuite{SupportedFeatures: sets.New(SupportGateway, SupportMesh)}
+	assert.Equal(t, []string{
+		"gateway-conformance-app-backend",
+		"gateway-conformance-infra",
+		"gateway-conformance-mesh",
+		"gateway-conformance-mesh-consumer",
+		"gateway-conformance-web-backend",
+	}, suite.testNamespaces())
+}
@@ -47,10 +47,19 @@ const (
 	// which covers HTTP functionality, such as the HTTPRoute API.
 	HTTPConformanceProfileName ConformanceProfileName = "HTTP"
 
+	// GRPCConformanceProfileName indicates the name of the conformance profile
+	// which covers gRPC functionality, such as the GRPCRoute API.
+	GRPCConformanceProfileName ConformanceProfileName = "GRPC"
+
 	// TLSConformanceProfileName indicates the name of the conformance profile
 	// which covers TLS stream functionality, such as the TLSRoute API.
 	TLSConformanceProfileName ConformanceProfileName = "TLS"
 
+	// L4ConformanceProfileName indicates the name of the conformance profile
+	// which covers TCP and UDP stream functionality, such as the TCPRoute and
+	// UDPRoute APIs.
+	L4ConformanceProfileName ConformanceProfileName = "L4"
+
 	// MeshConformanceProfileName indicates the name of the conformance profile
 	// which covers service mesh functionality.
 	MeshConformanceProfileName ConformanceProfileName = "MESH"
@@ -73,6 +82,18 @@ var (
 		ExtendedFeatures: HTTPExtendedFeatures,
 	}
 
+	// GRPCConformanceProfile is a ConformanceProfile that covers testing gRPC
+	// related functionality with Gateways.
+	GRPCConformanceProfile = ConformanceProfile{
+		Name: GRPCConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportGRPCRoute,
+		),
+		ExtendedFeatures: GRPCExtendedFeatures,
+	}
+
 	// TLSConformanceProfile is a ConformanceProfile that covers testing TLS
 	// related functionality with Gateways.
 	TLSConformanceProfile = ConformanceProfile{
@@ -84,6 +105,18 @@ var (
 		),
 	}
 
+	// L4ConformanceProfile is a ConformanceProfile that covers testing TCP
+	// and UDP related functionality with Gateways.
+	L4ConformanceProfile = ConformanceProfile{
+		Name: L4ConformanceProfileName,
+		CoreFeatures: sets.New(
+			SupportGateway,
+			SupportReferenceGrant,
+			SupportTCPRoute,
+		),
+		ExtendedFeatures: L4ExtendedFeatures,
+	}
+
 	// MeshConformanceProfile is a ConformanceProfile that covers testing
 	// service mesh related functionality.
 	MeshConformanceProfile = ConformanceProfile{
@@ -102,7 +135,9 @@ var (
 // ConformanceProfiles.
 var conformanceProfileMap = map[ConformanceProfileName]ConformanceProfile{
 	HTTPConformanceProfileName: HTTPConformanceProfile,
+	GRPCConformanceProfileName: GRPCConformanceProfile,
 	TLSConformanceProfileName:  TLSConformanceProfile,
+	L4ConformanceProfileName:   L4ConformanceProfile,
 	MeshConformanceProfileName: MeshConformanceProfile,
 }
 
@@ -20,8 +20,12 @@ limitations under the License.
 package suite
 
 import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
 // -----------------------------------------------------------------------------
@@ -29,8 +33,9 @@ import (
 // -----------------------------------------------------------------------------
 
 type testResult struct {
-	test   ConformanceTest
-	result resultType
+	test    ConformanceTest
+	result  resultType
+	metrics *testMetrics
 }
 
 type resultType string
@@ -40,6 +45,7 @@ var (
 	testFailed       resultType = "FAILED"
 	testSkipped      resultType = "SKIPPED"
 	testNotSupported resultType = "NOT_SUPPORTED"
+	testNotSelected  resultType = "NOT_SELECTED"
 )
 
 type profileReportsMap map[ConformanceProfileName]confv1a1.ProfileReport
@@ -104,6 +110,17 @@ func (p profileReportsMap) addTestResults(conformanceProfile ConformanceProfile,
 			}
 			report.Core.SkippedTests = append(report.Core.SkippedTests, result.test.ShortName)
 		}
+	case testNotSelected:
+		if testIsExtended {
+			if report.Extended == nil {
+				report.Extended = &confv1a1.ExtendedStatus{}
+			}
+			report.Extended.Statistics.NotSelected++
+			report.Extended.NotSelectedTests = append(report.Extended.NotSelectedTests, result.test.ShortName)
+		} else {
+			report.Core.Statistics.NotSelected++
+			report.Core.NotSelectedTests = append(report.Core.NotSelectedTests, result.test.ShortName)
+		}
 	}
 	p[conformanceProfile.Name] = report
 }
@@ -120,7 +137,7 @@ func (p profileReportsMap) compileResults(supportedFeaturesMap map[ConformancePr
 		// report the overall result for core features
 		if report.Core.Failed > 0 {
 			report.Core.Result = confv1a1.Failure
-		} else if report.Core.Skipped > 0 {
+		} else if report.Core.Skipped > 0 || report.Core.NotSelected > 0 {
 			report.Core.Result = confv1a1.Partial
 		} else {
 			report.Core.Result = confv1a1.Success
@@ -130,7 +147,7 @@ func (p profileReportsMap) compileResults(supportedFeaturesMap map[ConformancePr
 			// report the overall result for extended features
 			if report.Extended.Failed > 0 {
 				report.Extended.Result = confv1a1.Failure
-			} else if report.Extended.Skipped > 0 {
+			} else if report.Extended.Skipped > 0 || report.Extended.NotSelected > 0 {
 				report.Extended.Result = confv1a1.Partial
 			} else {
 				report.Extended.Result = confv1a1.Success
@@ -189,3 +206,43 @@ func isTestExtended(profile ConformanceProfile, test ConformanceTest) bool {
 	}
 	return false
 }
+
+// testMetricsReport returns the timing measurements of the tests that ran,
+// sorted by test name.
+func testMetricsReport(results map[string]testResult) []confv1a1.TestMetrics {
+	var report []confv1a1.TestMetrics
+	for name, result := range results {
+		if result.metrics == nil {
+			continue
+		}
+		metrics := confv1a1.TestMetrics{
+			Name:         name,
+			WallTime:     metav1.Duration{Duration: result.metrics.wallTime},
+			Convergences: uint32(result.metrics.convergences),
+			Attempts:     uint32(result.metrics.attempts),
+		}
+		if result.metrics.convergences > 0 {
+			metrics.TimeToFirstSuccess = &metav1.Duration{Duration: result.metrics.timeToFirstSuccess}
+			metrics.TimeToConsistency = &metav1.Duration{Duration: result.metrics.timeToConsistency}
+		}
+		report = append(report, metrics)
+	}
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Name < report[j].Name
+	})
+	return report
+}
+
+// nonConformantTimeoutsReport returns the timeouts of the test run that are
+// higher than allowed to claim conformance.
+func nonConformantTimeoutsReport(timeoutConfig config.TimeoutConfig) []confv1a1.NonConformantTimeout {
+	var report []confv1a1.NonConformantTimeout
+	for _, timeout := range config.NonConformantTimeouts(timeoutConfig) {
+		report = append(report, confv1a1.NonConformantTimeout{
+			Name:  timeout.Name,
+			Value: metav1.Duration{Duration: timeout.Value},
+			Max:   metav1.Duration{Duration: timeout.Max},
+		})
+	}
+	return report
+}
@@ -32,7 +32,9 @@ import (
 	"sigs.k8s.io/gateway-api/conformance"
 	confv1a1 "sigs.k8s.io/gateway-api/conformance/apis/v1alpha1"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
 )
 
@@ -92,6 +94,16 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
 	}
 
+	grpcRoundTripper := s.GRPCRoundTripper
+	if grpcRoundTripper == nil {
+		grpcRoundTripper = &grpc.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
+	l4RoundTripper := s.L4RoundTripper
+	if l4RoundTripper == nil {
+		l4RoundTripper = &l4.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
 	suite := &ExperimentalConformanceTestSuite{
 		results:                     make(map[string]testResult),
 		extendedUnsupportedFeatures: make(map[ConformanceProfileName]sets.Set[SupportedFeature]),
@@ -153,27 +165,46 @@ func NewExperimentalConformanceTestSuite(s ExperimentalConformanceOptions) (*Exp
 		Clientset:        s.Clientset,
 		RestConfig:       s.RestConfig,
 		RoundTripper:     roundTripper,
+		GRPCRoundTripper: grpcRoundTripper,
+		L4RoundTripper:   l4RoundTripper,
 		GatewayClassName: s.GatewayClassName,
 		Debug:            s.Debug,
 		Cleanup:          s.CleanupBaseResources,
 		BaseManifests:    s.BaseManifests,
+		GRPCManifests:    s.GRPCManifests,
+		L4Manifests:      s.L4Manifests,
 		MeshManifests:    s.MeshManifests,
 		Applier: kubernetes.Applier{
 			NamespaceLabels: s.NamespaceLabels,
+			NamespacePrefix: s.NamespacePrefix,
 		},
 		SupportedFeatures: s.SupportedFeatures,
 		TimeoutConfig:     s.TimeoutConfig,
 		SkipTests:         sets.New(s.SkipTests...),
+		RunTests:          sets.New(s.RunTests...),
+		TestPattern:       s.TestPattern,
 		FS:                *s.FS,
+		ResultsDir:        s.ResultsDir,
+		ArtifactsDir:      s.ArtifactsDir,
+		ParallelTests:     s.ParallelTests,
 	}
 
 	// apply defaults
 	if suite.BaseManifests == "" {
 		suite.BaseManifests = "base/manifests.yaml"
 	}
+	if suite.GRPCManifests == "" {
+		suite.GRPCManifests = "base/grpc-manifests.yaml"
+	}
+	if suite.L4Manifests == "" {
+		suite.L4Manifests = "base/l4-manifests.yaml"
+	}
 	if suite.MeshManifests == "" {
 		suite.MeshManifests = "mesh/manifests.yaml"
 	}
+	if suite.ParallelTests > 0 {
+		suite.parallelTests = make(chan struct{}, suite.ParallelTests)
+	}
 
 	return suite, nil
 }
@@ -204,12 +235,11 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 	suite.results = nil
 	suite.lock.Unlock()
 
+	suite.recordResults(t)
+
 	// run all tests and collect the test results for conformance reporting
 	results := make(map[string]testResult)
-	for _, test := range tests {
-		succeeded := t.Run(test.ShortName, func(t *testing.T) {
-			test.Run(t, &suite.ConformanceTestSuite)
-		})
+	suite.runTests(t, tests, func(test ConformanceTest, succeeded bool) {
 		res := testSucceeded
 		if suite.SkipTests.Has(test.ShortName) {
 			res = testSkipped
@@ -217,16 +247,20 @@ func (suite *ExperimentalConformanceTestSuite) Run(t *testing.T, tests []Conform
 		if !suite.SupportedFeatures.HasAll(test.Features...) {
 			res = testNotSupported
 		}
+		if !suite.isSelected(test) {
+			res = testNotSelected
+		}
 
 		if !succeeded {
 			res = testFailed
 		}
 
 		results[test.ShortName] = testResult{
-			test:   test,
-			result: res,
+			test:    test,
+			result:  res,
+			metrics: suite.metrics.get(test.ShortName),
 		}
-	}
+	})
 
 	// now that the tests have completed, mark the test suite as not running
 	// and report the test results.
@@ -267,10 +301,13 @@ func (suite *ExperimentalConformanceTestSuite) Report() (*confv1a1.ConformanceRe
 			APIVersion: "gateway.networking.k8s.io/v1alpha1",
 			Kind:       "ConformanceReport",
 		},
-		Date:              time.Now().Format(time.RFC3339),
-		Implementation:    suite.implementation,
-		GatewayAPIVersion: "TODO",
-		ProfileReports:    profileReports.list(),
+		Date:                  time.Now().Format(time.RFC3339),
+		Implementation:        suite.implementation,
+		GatewayAPIVersion:     suite.bundleInfo.Version,
+		GatewayAPIChannel:     suite.bundleInfo.Channel,
+		ProfileReports:        profileReports.list(),
+		TestMetrics:           testMetricsReport(suite.results),
+		NonConformantTimeouts: nonConformantTimeoutsReport(suite.TimeoutConfig),
 	}, nil
 }
 
@@ -75,6 +75,9 @@ var StandardExtendedFeatures = sets.New(
 const (
 	// This option indicates support for Destination Port matching.
 	SupportRouteDestinationPortMatching SupportedFeature = "RouteDestinationPortMatching"
+
+	// This option indicates support for HTTPRoute retries (experimental conformance).
+	SupportHTTPRouteRetry SupportedFeature = "HTTPRouteRetry"
 )
 
 // ExperimentalExtendedFeatures are extra generic features that are currently
@@ -85,6 +88,7 @@ const (
 // See: https://github.com/kubernetes-sigs/gateway-api/issues/1891
 var ExperimentalExtendedFeatures = sets.New(
 	SupportRouteDestinationPortMatching,
+	SupportHTTPRouteRetry,
 )
 
 // -----------------------------------------------------------------------------
@@ -133,6 +137,18 @@ const (
 
 	// This option indicates support for HTTPRoute request mirror (extended conformance).
 	SupportHTTPRouteRequestMirror SupportedFeature = "HTTPRouteRequestMirror"
+
+	// This option indicates support for HTTPRoute backends with the
+	// `kubernetes.io/h2c` appProtocol (extended conformance).
+	SupportHTTPRouteBackendProtocolH2C SupportedFeature = "HTTPRouteBackendProtocolH2C"
+
+	// This option indicates support for HTTPRoute backends with the
+	// `kubernetes.io/ws` appProtocol (extended conformance).
+	SupportHTTPRouteBackendProtocolWebSocket SupportedFeature = "HTTPRouteBackendProtocolWebSocket"
+
+	// This option indicates support for HTTPRoute backendRefs to multi-cluster
+	// ServiceImports (extended conformance), see GEP-1748.
+	SupportHTTPRouteServiceImportBackend SupportedFeature = "HTTPRouteServiceImportBackend"
 )
 
 // HTTPExtendedFeatures includes all the supported features for HTTPRoute
@@ -148,6 +164,53 @@ var HTTPExtendedFeatures = sets.New(
 	SupportHTTPRouteHostRewrite,
 	SupportHTTPRoutePathRewrite,
 	SupportHTTPRouteRequestMirror,
+	SupportHTTPRouteBackendProtocolH2C,
+	SupportHTTPRouteBackendProtocolWebSocket,
+	SupportHTTPRouteServiceImportBackend,
+)
+
+// -----------------------------------------------------------------------------
+// Features - GRPCRoute Conformance (Core)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for GRPCRoute
+	SupportGRPCRoute SupportedFeature = "GRPCRoute"
+)
+
+// GRPCCoreFeatures includes all SupportedFeatures needed to be conformant with
+// the GRPCRoute.
+var GRPCCoreFeatures = sets.New(
+	SupportGRPCRoute,
+)
+
+// -----------------------------------------------------------------------------
+// Features - GRPCRoute Conformance (Extended)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for GRPCRoute header matching (extended conformance).
+	SupportGRPCRouteHeaderMatching SupportedFeature = "GRPCRouteHeaderMatching"
+
+	// This option indicates support for GRPCRoute service and method matching
+	// with regular expressions (extended conformance).
+	SupportGRPCRouteMethodRegexMatching SupportedFeature = "GRPCRouteMethodRegexMatching"
+
+	// This option indicates support for GRPCRoute request header modification (extended conformance).
+	SupportGRPCRouteRequestHeaderModification SupportedFeature = "GRPCRouteRequestHeaderModification"
+
+	// This option indicates support for GRPCRoute response header modification (extended conformance).
+	SupportGRPCRouteResponseHeaderModification SupportedFeature = "GRPCRouteResponseHeaderModification"
+)
+
+// GRPCExtendedFeatures includes all the supported features for GRPCRoute
+// conformance and can be used to opt-in to run all GRPCRoute tests, including
+// extended features.
+var GRPCExtendedFeatures = sets.New(
+	SupportGRPCRouteHeaderMatching,
+	SupportGRPCRouteMethodRegexMatching,
+	SupportGRPCRouteRequestHeaderModification,
+	SupportGRPCRouteResponseHeaderModification,
 )
 
 // -----------------------------------------------------------------------------
@@ -165,6 +228,38 @@ var TLSCoreFeatures = sets.New(
 	SupportTLSRoute,
 )
 
+// -----------------------------------------------------------------------------
+// Features - L4 Conformance (Core)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for TCPRoute
+	SupportTCPRoute SupportedFeature = "TCPRoute"
+)
+
+// L4CoreFeatures includes all SupportedFeatures needed to be conformant with
+// the L4 profile.
+var L4CoreFeatures = sets.New(
+	SupportTCPRoute,
+)
+
+// -----------------------------------------------------------------------------
+// Features - L4 Conformance (Extended)
+// -----------------------------------------------------------------------------
+
+const (
+	// This option indicates support for UDPRoute
+	SupportUDPRoute SupportedFeature = "UDPRoute"
+)
+
+// L4ExtendedFeatures includes all the supported features for TCPRoute and
+// UDPRoute conformance and can be used to opt-in to run all L4 tests,
+// including extended features.
+var L4ExtendedFeatures = sets.New(
+	SupportUDPRoute,
+	SupportRouteDestinationPortMatching,
+)
+
 // -----------------------------------------------------------------------------
 // Features - Mesh Conformance (Core)
 // -----------------------------------------------------------------------------
@@ -193,5 +288,9 @@ var AllFeatures = sets.New[SupportedFeature]().
 	Insert(ExperimentalExtendedFeatures.UnsortedList()...).
 	Insert(HTTPCoreFeatures.UnsortedList()...).
 	Insert(HTTPExtendedFeatures.UnsortedList()...).
+	Insert(GRPCCoreFeatures.UnsortedList()...).
+	Insert(GRPCExtendedFeatures.UnsortedList()...).
 	Insert(TLSCoreFeatures.UnsortedList()...).
+	Insert(L4CoreFeatures.UnsortedList()...).
+	Insert(L4ExtendedFeatures.UnsortedList()...).
 	Insert(MeshCoreFeatures.UnsortedList()...)
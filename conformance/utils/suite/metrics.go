@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+)
+
+// testMetrics are the timing measurements of a test run.
+type testMetrics struct {
+	wallTime           time.Duration
+	convergences       int
+	attempts           int
+	timeToFirstSuccess time.Duration
+	timeToConsistency  time.Duration
+}
+
+// metricsRecorder records the timing measurements of the tests of a run.
+type metricsRecorder struct {
+	lock    sync.Mutex
+	metrics map[string]*testMetrics
+}
+
+func newMetricsRecorder() *metricsRecorder {
+	return &metricsRecorder{metrics: make(map[string]*testMetrics)}
+}
+
+// observe records the convergences of the test while it runs as t, and its
+// wall time until the cleanups registered before observe.
+func (r *metricsRecorder) observe(t *testing.T, test ConformanceTest, start time.Time) {
+	metrics := &testMetrics{}
+	r.lock.Lock()
+	r.metrics[test.ShortName] = metrics
+	r.lock.Unlock()
+
+	http.ObserveConvergence(t, func(c http.Convergence) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		metrics.convergences++
+		metrics.attempts += c.Attempts
+		if c.TimeToFirstSuccess > metrics.timeToFirstSuccess {
+			metrics.timeToFirstSuccess = c.TimeToFirstSuccess
+		}
+		if c.TimeToConsistency > metrics.timeToConsistency {
+			metrics.timeToConsistency = c.TimeToConsistency
+		}
+	})
+	t.Cleanup(func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		metrics.wallTime = time.Since(start)
+	})
+}
+
+// get returns a copy of the timing measurements of the test, if it ran.
+func (r *metricsRecorder) get(shortName string) *testMetrics {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	metrics, ok := r.metrics[shortName]
+	if !ok {
+		return nil
+	}
+	m := *metrics
+	return &m
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+)
+
+func TestRunRecordsMetrics(t *testing.T) {
+	suite := &ConformanceTestSuite{
+		SupportedFeatures: sets.New(SupportGateway),
+	}
+	tests := []ConformanceTest{{
+		ShortName: "Converging",
+		Features:  []SupportedFeature{SupportGateway},
+		Test: func(t *testing.T, s *ConformanceTestSuite) {
+			t.Run("first request", func(t *testing.T) {
+				http.AwaitConvergence(t, 3, time.Minute, func(time.Duration) bool { return true })
+			})
+			t.Run("second request", func(t *testing.T) {
+				http.AwaitConvergence(t, 2, time.Minute, func(time.Duration) bool { return true })
+			})
+		},
+	}, {
+		ShortName: "NoRequests",
+		Features:  []SupportedFeature{SupportGateway},
+		Test:      func(*testing.T, *ConformanceTestSuite) {},
+	}, {
+		ShortName: "NotSupported",
+		Features:  []SupportedFeature{SupportHTTPRoute},
+		Test:      func(*testing.T, *ConformanceTestSuite) {},
+	}}
+
+	t.Run("run", func(t *testing.T) {
+		suite.Run(t, tests)
+	})
+
+	converging := suite.metrics.get("Converging")
+	require.NotNil(t, converging)
+	assert.Equal(t, 2, converging.convergences)
+	assert.Equal(t, 5, converging.attempts)
+	assert.LessOrEqual(t, converging.timeToFirstSuccess, converging.timeToConsistency)
+	assert.LessOrEqual(t, converging.timeToConsistency, converging.wallTime)
+
+	noRequests := suite.metrics.get("NoRequests")
+	require.NotNil(t, noRequests)
+	assert.Equal(t, 0, noRequests.convergences)
+
+	assert.Nil(t, suite.metrics.get("NotSupported"), "skipped tests must not have metrics")
+}
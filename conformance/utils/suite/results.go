@@ -0,0 +1,291 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	// JUnitResultsFile is the name of the JUnit XML file written to the
+	// results directory once a run completes.
+	JUnitResultsFile = "junit.xml"
+
+	// JSONResultsFile is the name of the file in the results directory to
+	// which a JSON object is appended, one per line, as each test completes.
+	JSONResultsFile = "results.json"
+)
+
+// TestStatus is the outcome of a conformance test.
+type TestStatus string
+
+const (
+	TestPassed  TestStatus = "passed"
+	TestFailed  TestStatus = "failed"
+	TestSkipped TestStatus = "skipped"
+)
+
+// TestResult describes the outcome of a single conformance test, and is
+// written to the results directory when one is configured.
+type TestResult struct {
+	// Name is the full name of the test, as reported by go test, e.g.
+	// TestConformance/HTTPRouteSimpleSameNamespace.
+	Name string `json:"name"`
+	// ShortName is the ShortName of the ConformanceTest.
+	ShortName string             `json:"shortName"`
+	Features  []SupportedFeature `json:"features,omitempty"`
+	Status    TestStatus         `json:"status"`
+	// Duration is the duration of the test, in seconds.
+	Duration float64 `json:"duration"`
+	// FailureMessage describes why the test failed, naming its first failed
+	// subtest when it has one. The testing package does not expose the
+	// messages of failed assertions, so the details of a failure are only
+	// found in the test log.
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// SkipReason describes why the test was skipped.
+	SkipReason string `json:"skipReason,omitempty"`
+	// Subtests are the results of the subtests run with RunSubtest, in the
+	// order they completed. Their ShortName is their name relative to the
+	// test.
+	Subtests []TestResult `json:"subtests,omitempty"`
+}
+
+// resultsRecorder records the result of every conformance test of a run,
+// streaming them as JSON and writing them as JUnit XML once the run
+// completes.
+type resultsRecorder struct {
+	lock    sync.Mutex
+	dir     string
+	stream  *os.File
+	results []TestResult
+
+	// subtests holds the results of the subtests of the tests that are
+	// running, by the full name of the test.
+	subtests map[string][]TestResult
+}
+
+// newResultsRecorder creates the results directory, if needed, and truncates
+// any JSON results left in it by a previous run.
+func newResultsRecorder(dir string) (*resultsRecorder, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating results directory: %w", err)
+	}
+	stream, err := os.Create(filepath.Join(dir, JSONResultsFile))
+	if err != nil {
+		return nil, fmt.Errorf("error creating JSON results file: %w", err)
+	}
+	return &resultsRecorder{dir: dir, stream: stream, subtests: map[string][]TestResult{}}, nil
+}
+
+// start begins recording the subtests of a test that runs as t.
+func (r *resultsRecorder) start(t *testing.T) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.subtests[t.Name()] = nil
+}
+
+// recordSubtest records the result of a subtest, which ran as t, with the
+// test it belongs to. Subtests of tests that are not being recorded are
+// ignored.
+func (r *resultsRecorder) recordSubtest(t *testing.T, duration time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for name := path.Dir(t.Name()); name != "."; name = path.Dir(name) {
+		if subtests, ok := r.subtests[name]; ok {
+			result := TestResult{
+				Name:      t.Name(),
+				ShortName: strings.TrimPrefix(t.Name(), name+"/"),
+				Status:    TestPassed,
+				Duration:  duration.Seconds(),
+			}
+			switch {
+			case t.Failed():
+				result.Status = TestFailed
+				result.FailureMessage = fmt.Sprintf("%s failed, see the test log for details", result.ShortName)
+			case t.Skipped():
+				result.Status = TestSkipped
+			}
+			r.subtests[name] = append(subtests, result)
+			return
+		}
+	}
+}
+
+// record appends the result of test, which ran as t, once t and all of its
+// subtests have completed.
+func (r *resultsRecorder) record(t *testing.T, test ConformanceTest, duration time.Duration, skipReason string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	result := TestResult{
+		Name:      t.Name(),
+		ShortName: test.ShortName,
+		Features:  test.Features,
+		Status:    TestPassed,
+		Duration:  duration.Seconds(),
+		Subtests:  r.subtests[t.Name()],
+	}
+	delete(r.subtests, t.Name())
+	switch {
+	case t.Failed():
+		result.Status = TestFailed
+		result.FailureMessage = fmt.Sprintf("%s failed, see the test log for details", test.ShortName)
+		for _, subtest := range result.Subtests {
+			if subtest.Status == TestFailed {
+				result.FailureMessage = fmt.Sprintf("%s failed in subtest %q, see the test log for details", test.ShortName, subtest.ShortName)
+				break
+			}
+		}
+	case t.Skipped():
+		result.Status = TestSkipped
+		result.SkipReason = skipReason
+	}
+
+	r.results = append(r.results, result)
+	line, err := json.Marshal(result)
+	if err == nil {
+		_, err = r.stream.Write(append(line, '\n'))
+	}
+	if err != nil {
+		t.Logf("WARNING: unable to write the result of %s to %s: %v", test.ShortName, JSONResultsFile, err)
+	}
+}
+
+// close closes the JSON results and writes all recorded results as a JUnit
+// XML test suite with the given name.
+func (r *resultsRecorder) close(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.stream.Close(); err != nil {
+		return fmt.Errorf("error closing JSON results file: %w", err)
+	}
+
+	out, err := xml.MarshalIndent(junitTestSuites(name, r.results), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding JUnit results: %w", err)
+	}
+	out = append([]byte(xml.Header), append(out, '\n')...)
+	if err := os.WriteFile(filepath.Join(r.dir, JUnitResultsFile), out, 0o644); err != nil {
+		return fmt.Errorf("error writing JUnit results file: %w", err)
+	}
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// JUnit XML - Private Types
+// -----------------------------------------------------------------------------
+
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name       string        `xml:"name,attr"`
+	ClassName  string        `xml:"classname,attr"`
+	Time       string        `xml:"time,attr"`
+	Properties *junitProps   `xml:"properties,omitempty"`
+	Failure    *junitMessage `xml:"failure,omitempty"`
+	Skipped    *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitProps struct {
+	Properties []junitProp `xml:"property"`
+}
+
+type junitProp struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+}
+
+func junitTestSuites(name string, results []TestResult) junitSuites {
+	suite := junitSuite{Name: name}
+	var total float64
+	for _, result := range results {
+		suite.Cases = append(suite.Cases, junitTestCases(name, result)...)
+		total += result.Duration
+	}
+	for _, c := range suite.Cases {
+		switch {
+		case c.Failure != nil:
+			suite.Failures++
+		case c.Skipped != nil:
+			suite.Skipped++
+		}
+	}
+	suite.Tests = len(suite.Cases)
+	suite.Time = junitTime(total)
+	return junitSuites{Suites: []junitSuite{suite}}
+}
+
+// junitTestCases returns the JUnit test cases of a test, followed by those of
+// its subtests, which are named after the test.
+func junitTestCases(className string, result TestResult) []junitCase {
+	c := junitCase{
+		Name:      result.ShortName,
+		ClassName: className,
+		Time:      junitTime(result.Duration),
+	}
+	if len(result.Features) > 0 {
+		c.Properties = &junitProps{}
+		for _, feature := range result.Features {
+			c.Properties.Properties = append(c.Properties.Properties, junitProp{Name: "feature", Value: string(feature)})
+		}
+	}
+	switch result.Status {
+	case TestFailed:
+		c.Failure = &junitMessage{Message: result.FailureMessage}
+	case TestSkipped:
+		c.Skipped = &junitMessage{Message: result.SkipReason}
+	}
+
+	cases := []junitCase{c}
+	for _, subtest := range result.Subtests {
+		subtest.ShortName = result.ShortName + "/" + subtest.ShortName
+		cases = append(cases, junitTestCases(className, subtest)...)
+	}
+	return cases
+}
+
+func junitTime(seconds float64) string {
+	return fmt.Sprintf("%.3f", seconds)
+}
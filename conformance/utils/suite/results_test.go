@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suite
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestRunRecordsResults(t *testing.T) {
+	dir := t.TempDir()
+	suite := &ConformanceTestSuite{
+		SupportedFeatures: sets.New(SupportGateway),
+		SkipTests:         sets.New("ExplicitlySkipped"),
+		ResultsDir:        dir,
+	}
+	tests := []ConformanceTest{{
+		ShortName: "Passing",
+		Features:  []SupportedFeature{SupportGateway},
+		Test: func(t *testing.T, s *ConformanceTestSuite) {
+			s.RunSubtest(t, "first", func(t *testing.T) {})
+			s.RunSubtest(t, "second", func(t *testing.T) {
+				s.RunSubtest(t, "nested", func(t *testing.T) {})
+			})
+			s.RunSubtest(t, "skipped", func(t *testing.T) {
+				t.Skip("not applicable")
+			})
+		},
+	}, {
+		ShortName: "NotSupported",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		Test:      func(*testing.T, *ConformanceTestSuite) {},
+	}, {
+		ShortName: "ExplicitlySkipped",
+		Test:      func(*testing.T, *ConformanceTestSuite) {},
+	}}
+
+	t.Run("run", func(t *testing.T) {
+		suite.Run(t, tests)
+	})
+
+	f, err := os.Open(filepath.Join(dir, JSONResultsFile))
+	require.NoError(t, err)
+	defer f.Close()
+	var results []TestResult
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var result TestResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &result))
+		result.Duration = 0
+		for i := range result.Subtests {
+			result.Subtests[i].Duration = 0
+		}
+		results = append(results, result)
+	}
+	assert.Equal(t, []TestResult{{
+		Name:      "TestRunRecordsResults/run/Passing",
+		ShortName: "Passing",
+		Features:  []SupportedFeature{SupportGateway},
+		Status:    TestPassed,
+		Subtests: []TestResult{{
+			Name:      "TestRunRecordsResults/run/Passing/first",
+			ShortName: "first",
+			Status:    TestPassed,
+		}, {
+			Name:      "TestRunRecordsResults/run/Passing/second/nested",
+			ShortName: "second/nested",
+			Status:    TestPassed,
+		}, {
+			Name:      "TestRunRecordsResults/run/Passing/second",
+			ShortName: "second",
+			Status:    TestPassed,
+		}, {
+			Name:      "TestRunRecordsResults/run/Passing/skipped",
+			ShortName: "skipped",
+			Status:    TestSkipped,
+		}},
+	}, {
+		Name:       "TestRunRecordsResults/run/NotSupported",
+		ShortName:  "NotSupported",
+		Features:   []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		Status:     TestSkipped,
+		SkipReason: "suite does not support HTTPRoute",
+	}, {
+		Name:       "TestRunRecordsResults/run/ExplicitlySkipped",
+		ShortName:  "ExplicitlySkipped",
+		Status:     TestSkipped,
+		SkipReason: "test explicitly skipped",
+	}}, results)
+
+	out, err := os.ReadFile(filepath.Join(dir, JUnitResultsFile))
+	require.NoError(t, err)
+	var junit junitSuites
+	require.NoError(t, xml.Unmarshal(out, &junit))
+	require.Len(t, junit.Suites, 1)
+	assert.Equal(t, "TestRunRecordsResults/run", junit.Suites[0].Name)
+	assert.Equal(t, 7, junit.Suites[0].Tests)
+	assert.Equal(t, 3, junit.Suites[0].Skipped)
+	assert.Nil(t, suite.testResults)
+}
+
+func TestJUnitTestSuites(t *testing.T) {
+	junit := junitTestSuites("TestConformance", []TestResult{{
+		ShortName:      "Failing",
+		Features:       []SupportedFeature{SupportGateway},
+		Status:         TestFailed,
+		Duration:       1.5,
+		FailureMessage: `Failing failed in subtest "request", see the test log for details`,
+		Subtests: []TestResult{{
+			ShortName:      "request",
+			Status:         TestFailed,
+			Duration:       1,
+			FailureMessage: "request failed, see the test log for details",
+		}},
+	}, {
+		ShortName: "Passing",
+		Status:    TestPassed,
+		Duration:  0.25,
+	}})
+
+	out, err := xml.MarshalIndent(junit, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, `<testsuites>
+  <testsuite name="TestConformance" tests="3" failures="2" skipped="0" time="1.750">
+    <testcase name="Failing" classname="TestConformance" time="1.500">
+      <properties>
+        <property name="feature" value="Gateway"></property>
+      </properties>
+      <failure message="Failing failed in subtest &#34;request&#34;, see the test log for details"></failure>
+    </testcase>
+    <testcase name="Failing/request" classname="TestConformance" time="1.000">
+      <failure message="request failed, see the test log for details"></failure>
+    </testcase>
+    <testcase name="Passing" classname="TestConformance" time="0.250"></testcase>
+  </testsuite>
+</testsuites>`, string(out))
+}
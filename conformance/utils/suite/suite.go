@@ -17,9 +17,17 @@ limitations under the License.
 package suite
 
 import (
+	"context"
 	"embed"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
 	"strings"
+	"sync"
 	"testing"
+	"text/tabwriter"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	clientset "k8s.io/client-go/kubernetes"
@@ -28,8 +36,11 @@ import (
 
 	"sigs.k8s.io/gateway-api/conformance"
 	"sigs.k8s.io/gateway-api/conformance/utils/config"
+	"sigs.k8s.io/gateway-api/conformance/utils/grpc"
 	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/l4"
 	"sigs.k8s.io/gateway-api/conformance/utils/roundtripper"
+	"sigs.k8s.io/gateway-api/pkg/consts"
 )
 
 // ConformanceTestSuite defines the test suite used to run Gateway API
@@ -40,17 +51,47 @@ type ConformanceTestSuite struct {
 	RESTClient        *rest.RESTClient
 	RestConfig        *rest.Config
 	RoundTripper      roundtripper.RoundTripper
+	GRPCRoundTripper  grpc.RoundTripper
+	L4RoundTripper    l4.RoundTripper
 	GatewayClassName  string
 	ControllerName    string
 	Debug             bool
 	Cleanup           bool
 	BaseManifests     string
+	GRPCManifests     string
+	L4Manifests       string
 	MeshManifests     string
 	Applier           kubernetes.Applier
 	SupportedFeatures sets.Set[SupportedFeature]
 	TimeoutConfig     config.TimeoutConfig
 	SkipTests         sets.Set[string]
+	RunTests          sets.Set[string]
+	TestPattern       *regexp.Regexp
 	FS                embed.FS
+	ResultsDir        string
+	ArtifactsDir      string
+	ParallelTests     int
+
+	// metrics records the timing measurements of the tests during a run.
+	metrics *metricsRecorder
+
+	// roundTrips, grpcRoundTrips and l4RoundTrips record the round trips of
+	// a test to write them to its artifacts if it fails.
+	roundTrips     *roundtripper.RecordingRoundTripper
+	grpcRoundTrips *grpc.RecordingRoundTripper
+	l4RoundTrips   *l4.RecordingRoundTripper
+
+	// parallelTests limits the number of tests running in parallel to
+	// ParallelTests.
+	parallelTests chan struct{}
+
+	// bundleInfo describes the Gateway API CRDs installed in the cluster,
+	// and is populated by Setup.
+	bundleInfo kubernetes.BundleInfo
+
+	// testResults records the result of every test to ResultsDir during a
+	// run.
+	testResults *resultsRecorder
 }
 
 // Options can be used to initialize a ConformanceTestSuite.
@@ -61,9 +102,17 @@ type Options struct {
 	GatewayClassName string
 	Debug            bool
 	RoundTripper     roundtripper.RoundTripper
+	GRPCRoundTripper grpc.RoundTripper
+	L4RoundTripper   l4.RoundTripper
 	BaseManifests    string
+	GRPCManifests    string
+	L4Manifests      string
 	MeshManifests    string
 	NamespaceLabels  map[string]string
+	// NamespacePrefix is prepended to the names of all test Namespaces, and
+	// to the values of the labels they are selected by, so that several runs
+	// of the suite can share a cluster.
+	NamespacePrefix string
 
 	// CleanupBaseResources indicates whether or not the base test
 	// resources such as Gateways should be cleaned up after the run.
@@ -75,8 +124,32 @@ type Options struct {
 	// SkipTests contains all the tests not to be run and can be used to opt out
 	// of specific tests
 	SkipTests []string
+	// RunTests contains the only tests to be run, if any, and can be used to
+	// run specific tests, e.g. when debugging them.
+	RunTests []string
+	// TestPattern, if set, only runs the tests whose ShortName matches it.
+	// Tests must also be in RunTests when both are set.
+	TestPattern *regexp.Regexp
 
 	FS *embed.FS
+
+	// ResultsDir is the directory where the result of every test is written,
+	// both as a stream of JSON objects and as JUnit XML. Results are not
+	// written if it is empty.
+	ResultsDir string
+
+	// ArtifactsDir is the directory where diagnostics are written for every
+	// test that fails, in a directory named after the test. They include the
+	// Gateway API resources, the events and the Pod logs in the test
+	// Namespaces, and the last round trips of the test. No diagnostics are
+	// written if it is empty.
+	ArtifactsDir string
+
+	// ParallelTests, if greater than zero, runs the tests that set Parallel
+	// concurrently, at most ParallelTests at a time, after all other tests.
+	// Each of them gets its own copy of the base resources, in Namespaces and
+	// with Gateways prefixed for the test.
+	ParallelTests int
 }
 
 // New returns a new ConformanceTestSuite.
@@ -88,6 +161,16 @@ func New(s Options) *ConformanceTestSuite {
 		roundTripper = &roundtripper.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
 	}
 
+	grpcRoundTripper := s.GRPCRoundTripper
+	if grpcRoundTripper == nil {
+		grpcRoundTripper = &grpc.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
+	l4RoundTripper := s.L4RoundTripper
+	if l4RoundTripper == nil {
+		l4RoundTripper = &l4.DefaultRoundTripper{Debug: s.Debug, TimeoutConfig: s.TimeoutConfig}
+	}
+
 	switch {
 	case s.EnableAllSupportedFeatures == true:
 		s.SupportedFeatures = AllFeatures
@@ -112,27 +195,46 @@ func New(s Options) *ConformanceTestSuite {
 		Clientset:        s.Clientset,
 		RestConfig:       s.RestConfig,
 		RoundTripper:     roundTripper,
+		GRPCRoundTripper: grpcRoundTripper,
+		L4RoundTripper:   l4RoundTripper,
 		GatewayClassName: s.GatewayClassName,
 		Debug:            s.Debug,
 		Cleanup:          s.CleanupBaseResources,
 		BaseManifests:    s.BaseManifests,
+		GRPCManifests:    s.GRPCManifests,
+		L4Manifests:      s.L4Manifests,
 		MeshManifests:    s.MeshManifests,
 		Applier: kubernetes.Applier{
 			NamespaceLabels: s.NamespaceLabels,
+			NamespacePrefix: s.NamespacePrefix,
 		},
 		SupportedFeatures: s.SupportedFeatures,
 		TimeoutConfig:     s.TimeoutConfig,
 		SkipTests:         sets.New(s.SkipTests...),
+		RunTests:          sets.New(s.RunTests...),
+		TestPattern:       s.TestPattern,
 		FS:                *s.FS,
+		ResultsDir:        s.ResultsDir,
+		ArtifactsDir:      s.ArtifactsDir,
+		ParallelTests:     s.ParallelTests,
 	}
 
 	// apply defaults
 	if suite.BaseManifests == "" {
 		suite.BaseManifests = "base/manifests.yaml"
 	}
+	if suite.GRPCManifests == "" {
+		suite.GRPCManifests = "base/grpc-manifests.yaml"
+	}
+	if suite.L4Manifests == "" {
+		suite.L4Manifests = "base/l4-manifests.yaml"
+	}
 	if suite.MeshManifests == "" {
 		suite.MeshManifests = "mesh/manifests.yaml"
 	}
+	if suite.ParallelTests > 0 {
+		suite.parallelTests = make(chan struct{}, suite.ParallelTests)
+	}
 
 	return suite
 }
@@ -142,55 +244,159 @@ func New(s Options) *ConformanceTestSuite {
 func (suite *ConformanceTestSuite) Setup(t *testing.T) {
 	suite.Applier.FS = suite.FS
 
+	for _, timeout := range config.NonConformantTimeouts(suite.TimeoutConfig) {
+		t.Logf("Test Setup: %s is %v, more than the %v allowed to claim conformance", timeout.Name, timeout.Value, timeout.Max)
+	}
+
+	t.Logf("Test Setup: Checking the installed Gateway API CRDs")
+	suite.checkBundleInfo(t)
+
 	if suite.SupportedFeatures.Has(SupportGateway) {
 		t.Logf("Test Setup: Ensuring GatewayClass has been accepted")
 		suite.ControllerName = kubernetes.GWCMustHaveAcceptedConditionTrue(t, suite.Client, suite.TimeoutConfig, suite.GatewayClassName)
 
 		suite.Applier.GatewayClass = suite.GatewayClassName
 		suite.Applier.ControllerName = suite.ControllerName
+	}
 
+	suite.applyBaseResources(t, suite.Cleanup)
+}
+
+// applyBaseResources applies the base manifests, and the resources created
+// programmatically for them, and ensures that they are ready.
+func (suite *ConformanceTestSuite) applyBaseResources(t *testing.T, cleanup bool) {
+	if suite.SupportedFeatures.Has(SupportGateway) {
 		t.Logf("Test Setup: Applying base manifests")
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.BaseManifests, suite.Cleanup)
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.BaseManifests, cleanup)
+		if suite.SupportedFeatures.Has(SupportGRPCRoute) {
+			t.Logf("Test Setup: Applying GRPCRoute manifests")
+			suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.GRPCManifests, cleanup)
+		}
+		if suite.SupportedFeatures.HasAny(SupportTCPRoute, SupportUDPRoute) {
+			t.Logf("Test Setup: Applying TCPRoute and UDPRoute manifests")
+			suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.L4Manifests, cleanup)
+		}
 
 		t.Logf("Test Setup: Applying programmatic resources")
-		secret := kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-web-backend", "certificate", []string{"*"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-infra", "tls-validity-checks-certificate", []string{"*", "*.org"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-infra", "tls-passthrough-checks-certificate", []string{"abc.example.com"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
-		secret = kubernetes.MustCreateSelfSignedCertSecret(t, "gateway-conformance-app-backend", "tls-passthrough-checks-certificate", []string{"abc.example.com"})
-		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, suite.Cleanup)
+		secret := kubernetes.MustCreateSelfSignedCertSecret(t, suite.WebBackendNamespace(), "certificate", []string{"*"})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+		secret = kubernetes.MustCreateSelfSignedCertSecret(t, suite.InfraNamespace(), "tls-validity-checks-certificate", []string{"*", "*.org"})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+		secret = kubernetes.MustCreateSelfSignedCertSecret(t, suite.InfraNamespace(), "tls-passthrough-checks-certificate", []string{"abc.example.com"})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
+		secret = kubernetes.MustCreateSelfSignedCertSecret(t, suite.AppBackendNamespace(), "tls-passthrough-checks-certificate", []string{"abc.example.com"})
+		suite.Applier.MustApplyObjectsWithCleanup(t, suite.Client, suite.TimeoutConfig, []client.Object{secret}, cleanup)
 
 		t.Logf("Test Setup: Ensuring Gateways and Pods from base manifests are ready")
 		namespaces := []string{
-			"gateway-conformance-infra",
-			"gateway-conformance-app-backend",
-			"gateway-conformance-web-backend",
+			suite.InfraNamespace(),
+			suite.AppBackendNamespace(),
+			suite.WebBackendNamespace(),
 		}
 		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
 	}
 	if suite.SupportedFeatures.Has(SupportMesh) {
 		t.Logf("Test Setup: Applying base manifests")
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.MeshManifests, suite.Cleanup)
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, suite.MeshManifests, cleanup)
 		t.Logf("Test Setup: Ensuring Gateways and Pods from mesh manifests are ready")
 		namespaces := []string{
-			"gateway-conformance-mesh",
-			"gateway-conformance-mesh-consumer",
-			"gateway-conformance-app-backend",
-			"gateway-conformance-web-backend",
+			suite.MeshNamespace(),
+			suite.MeshConsumerNamespace(),
+			suite.AppBackendNamespace(),
+			suite.WebBackendNamespace(),
 		}
 		kubernetes.NamespacesMustBeReady(t, suite.Client, suite.TimeoutConfig, namespaces)
 	}
 }
 
+// Namespace returns the name of the Namespace that tests run by the suite use
+// for a Namespace with the given name in the manifests. It differs from name
+// when the suite has a NamespacePrefix, and for tests that run in parallel
+// with other tests.
+func (suite *ConformanceTestSuite) Namespace(name string) string {
+	return suite.Applier.Namespace(name)
+}
+
+// InfraNamespace returns the name of the Namespace with the base Gateways and
+// the backends in the same Namespace as them.
+func (suite *ConformanceTestSuite) InfraNamespace() string {
+	return suite.Namespace("gateway-conformance-infra")
+}
+
+// AppBackendNamespace returns the name of the Namespace with the app and TLS
+// backends that routes reference across Namespaces.
+func (suite *ConformanceTestSuite) AppBackendNamespace() string {
+	return suite.Namespace("gateway-conformance-app-backend")
+}
+
+// WebBackendNamespace returns the name of the Namespace with the web backends
+// and their certificate, that routes reference across Namespaces.
+func (suite *ConformanceTestSuite) WebBackendNamespace() string {
+	return suite.Namespace("gateway-conformance-web-backend")
+}
+
+// MeshNamespace returns the name of the Namespace with the mesh workloads.
+func (suite *ConformanceTestSuite) MeshNamespace() string {
+	return suite.Namespace("gateway-conformance-mesh")
+}
+
+// MeshConsumerNamespace returns the name of the Namespace with the mesh
+// workloads that consume services in the MeshNamespace.
+func (suite *ConformanceTestSuite) MeshConsumerNamespace() string {
+	return suite.Namespace("gateway-conformance-mesh-consumer")
+}
+
+// GatewayName returns the name of the Gateway that tests run by the suite use
+// for a Gateway with the given name in the manifests. It differs from name
+// only for tests that run in parallel with other tests.
+func (suite *ConformanceTestSuite) GatewayName(name string) string {
+	return suite.Applier.GatewayName(name)
+}
+
 // Run runs the provided set of conformance tests.
 func (suite *ConformanceTestSuite) Run(t *testing.T, tests []ConformanceTest) {
+	suite.recordResults(t)
+	suite.runTests(t, tests, func(ConformanceTest, bool) {})
+}
+
+// runTests runs the tests as subtests of t, and calls done with each test and
+// whether it succeeded once it has completed. The tests that run in parallel
+// are run after all other tests, as subtests of a "Parallel" subtest, so that
+// tests that share resources never run at the same time as any other test.
+func (suite *ConformanceTestSuite) runTests(t *testing.T, tests []ConformanceTest, done func(test ConformanceTest, succeeded bool)) {
+	suite.metrics = newMetricsRecorder()
+
+	var parallel []ConformanceTest
 	for _, test := range tests {
-		t.Run(test.ShortName, func(t *testing.T) {
+		test := test
+		if suite.runsInParallel(test) {
+			parallel = append(parallel, test)
+			continue
+		}
+		succeeded := t.Run(test.ShortName, func(t *testing.T) {
 			test.Run(t, suite)
 		})
+		done(test, succeeded)
+	}
+
+	if len(parallel) == 0 {
+		return
 	}
+
+	var lock sync.Mutex
+	t.Run("Parallel", func(t *testing.T) {
+		for _, test := range parallel {
+			test := test
+			t.Run(test.ShortName, func(t *testing.T) {
+				t.Cleanup(func() {
+					lock.Lock()
+					defer lock.Unlock()
+					done(test, !t.Failed())
+				})
+				test.Run(t, suite)
+			})
+		}
+	})
 }
 
 // ConformanceTest is used to define each individual conformance test.
@@ -200,36 +406,161 @@ type ConformanceTest struct {
 	Features    []SupportedFeature
 	Manifests   []string
 	Slow        bool
-	Parallel    bool
-	Test        func(*testing.T, *ConformanceTestSuite)
+	// Parallel indicates that the test only uses the Namespaces and Gateways
+	// given by the Namespace and GatewayName methods of the suite, so that it
+	// can run in parallel with other tests when the suite sets ParallelTests.
+	// Tests that modify shared resources, such as the GatewayClass, or that
+	// wait for every Gateway in a Namespace, must not set it.
+	Parallel bool
+	Test     func(*testing.T, *ConformanceTestSuite)
 }
 
 // Run runs an individual tests, applying and cleaning up the required manifests
 // before calling the Test function.
 func (test *ConformanceTest) Run(t *testing.T, suite *ConformanceTestSuite) {
-	if test.Parallel {
+	if suite.runsInParallel(*test) {
 		t.Parallel()
 	}
 
+	var skipReason string
+	if suite.testResults != nil {
+		results, start := suite.testResults, time.Now()
+		results.start(t)
+		t.Cleanup(func() {
+			results.record(t, *test, time.Since(start), skipReason)
+		})
+	}
+
+	if skipReason = suite.skipReason(*test); skipReason != "" {
+		t.Skipf("Skipping %s: %s", test.ShortName, skipReason)
+	}
+
+	start := time.Now()
+
+	if suite.runsInParallel(*test) {
+		suite = suite.isolate(t, *test)
+	}
+	if suite.ArtifactsDir != "" {
+		suite = suite.recordRoundTrips()
+	}
+
+	for _, manifestLocation := range test.Manifests {
+		t.Logf("Applying %s", manifestLocation)
+		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, true)
+	}
+
+	if suite.ArtifactsDir != "" {
+		suite.writeArtifactsOnFailure(t)
+	}
+	if suite.metrics != nil {
+		suite.metrics.observe(t, *test, start)
+	}
+
+	test.Test(t, suite)
+}
+
+// RunSubtest runs f as a subtest of t named name, like t.Run, and records its
+// result with the result of the test when the suite records results. Tests
+// should run their subtests with RunSubtest rather than t.Run so that they
+// are reported.
+func (suite *ConformanceTestSuite) RunSubtest(t *testing.T, name string, f func(t *testing.T)) bool {
+	results := suite.testResults
+	return t.Run(name, func(t *testing.T) {
+		if results != nil {
+			start := time.Now()
+			t.Cleanup(func() {
+				results.recordSubtest(t, time.Since(start))
+			})
+		}
+		f(t)
+	})
+}
+
+// runsInParallel reports whether the test runs in parallel with other tests.
+func (suite *ConformanceTestSuite) runsInParallel(test ConformanceTest) bool {
+	return test.Parallel && suite.ParallelTests > 0
+}
+
+// isolate waits until fewer than ParallelTests tests are running in parallel,
+// and returns a copy of the suite for the test to run in parallel with them.
+// The copy applies its own base resources, and the test's manifests, to
+// Namespaces and Gateways prefixed for the test, its Gateways only admit
+// routes from these Namespaces, and its Client can't modify resources in any
+// other Namespace.
+func (suite *ConformanceTestSuite) isolate(t *testing.T, test ConformanceTest) *ConformanceTestSuite {
+	suite.parallelTests <- struct{}{}
+	t.Cleanup(func() { <-suite.parallelTests })
+
+	isolated := *suite
+	isolated.Applier.Prefix = isolationPrefix(test)
+	isolated.Applier.Namespaces = []string{"gateway-conformance-infra", "gateway-conformance-app-backend", "gateway-conformance-web-backend"}
+	isolated.Client = kubernetes.IsolatedClient(suite.Client, isolated.Applier.Prefix)
+
+	t.Logf("Test Setup: Isolating %s with prefix %s", test.ShortName, isolated.Applier.Prefix)
+	isolated.applyBaseResources(t, true)
+	return &isolated
+}
+
+// isolationPrefix returns the prefix for the Namespaces and Gateways of a test
+// that runs in parallel with other tests. It is short enough to keep the
+// Namespace names valid DNS labels.
+func isolationPrefix(test ConformanceTest) string {
+	h := fnv.New32a()
+	h.Write([]byte(test.ShortName))
+	return fmt.Sprintf("gwc-%08x-", h.Sum32())
+}
+
+// isSelected reports whether the test is selected to run by RunTests and
+// TestPattern, regardless of the features it exercises.
+func (suite *ConformanceTestSuite) isSelected(test ConformanceTest) bool {
+	if suite.RunTests.Len() > 0 && !suite.RunTests.Has(test.ShortName) {
+		return false
+	}
+	if suite.TestPattern != nil && !suite.TestPattern.MatchString(test.ShortName) {
+		return false
+	}
+	return true
+}
+
+// skipReason returns why the test will not be run by the suite, or an empty
+// string if it will be.
+func (suite *ConformanceTestSuite) skipReason(test ConformanceTest) string {
+	if !suite.isSelected(test) {
+		return "test not selected to run"
+	}
+
 	// Check that all features exercised by the test have been opted into by
 	// the suite.
 	for _, feature := range test.Features {
 		if !suite.SupportedFeatures.Has(feature) {
-			t.Skipf("Skipping %s: suite does not support %s", test.ShortName, feature)
+			return fmt.Sprintf("suite does not support %s", feature)
 		}
 	}
 
 	// check that the test should not be skipped
 	if suite.SkipTests.Has(test.ShortName) {
-		t.Skipf("Skipping %s: test explicitly skipped", test.ShortName)
+		return "test explicitly skipped"
 	}
 
-	for _, manifestLocation := range test.Manifests {
-		t.Logf("Applying %s", manifestLocation)
-		suite.Applier.MustApplyWithCleanup(t, suite.Client, suite.TimeoutConfig, manifestLocation, true)
-	}
+	return ""
+}
 
-	test.Test(t, suite)
+// ListTests writes the tests that the suite would run, with the features they
+// exercise and the manifests they apply, without running them.
+func (suite *ConformanceTestSuite) ListTests(w io.Writer, tests []ConformanceTest) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TEST\tFEATURES\tMANIFESTS")
+	for _, test := range tests {
+		if suite.skipReason(test) != "" {
+			continue
+		}
+		features := make([]string, 0, len(test.Features))
+		for _, feature := range test.Features {
+			features = append(features, string(feature))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", test.ShortName, strings.Join(features, ","), strings.Join(test.Manifests, ","))
+	}
+	return tw.Flush()
 }
 
 // ParseSupportedFeatures parses flag arguments and converts the string to
@@ -269,3 +600,98 @@ func ParseSkipTests(t string) []string {
 	}
 	return strings.Split(t, ",")
 }
+
+// recordResults records the result of every test run as a subtest of t to
+// ResultsDir, if set, until t and all of its subtests have completed.
+func (suite *ConformanceTestSuite) recordResults(t *testing.T) {
+	if suite.ResultsDir == "" {
+		return
+	}
+
+	results, err := newResultsRecorder(suite.ResultsDir)
+	if err != nil {
+		t.Fatalf("Error recording test results: %v", err)
+	}
+	suite.testResults = results
+	t.Cleanup(func() {
+		suite.testResults = nil
+		if err := results.close(t.Name()); err != nil {
+			t.Errorf("Error writing test results: %v", err)
+		}
+	})
+}
+
+// ParseRunTests parses flag arguments and converts the string to
+// []string containing the only tests to be run.
+func ParseRunTests(t string) []string {
+	if t == "" {
+		return nil
+	}
+	return strings.Split(t, ",")
+}
+
+// MaxNamespacePrefixLength is the length of the longest NamespacePrefix that
+// keeps the names of all test Namespaces, and the values of the labels they
+// are selected by, valid for tests that run in parallel with their own
+// isolation prefix, e.g. gwc-0123abcd-gateway-conformance-mesh-consumer.
+const MaxNamespacePrefixLength = 63 - len("gwc-00000000-") - len("gateway-conformance-mesh-consumer")
+
+var namespacePrefixRegexp = regexp.MustCompile(`^[a-z0-9][-a-z0-9]*$`)
+
+// ParseNamespacePrefix parses flag arguments and returns the prefix to add to
+// the names of all test Namespaces. It returns an error if the prefix would
+// make any Namespace name or label value invalid.
+func ParseNamespacePrefix(p string) (string, error) {
+	if p == "" {
+		return "", nil
+	}
+	if len(p) > MaxNamespacePrefixLength {
+		return "", fmt.Errorf("namespace prefix %q is %d characters long, it must be at most %d so that the names and labels of the test namespaces are at most 63 characters", p, len(p), MaxNamespacePrefixLength)
+	}
+	if !namespacePrefixRegexp.MatchString(p) {
+		return "", fmt.Errorf("namespace prefix %q must consist of lower case alphanumeric characters or '-', and must start with an alphanumeric character", p)
+	}
+	return p, nil
+}
+
+// ParseTestPattern parses flag arguments and compiles the string to a
+// regular expression matching the ShortNames of the tests to be run.
+func ParseTestPattern(p string) (*regexp.Regexp, error) {
+	if p == "" {
+		return nil, nil
+	}
+	return regexp.Compile(p)
+}
+
+// ParseTimeoutConfig parses flag arguments and returns the default timeouts
+// updated with the ones in the file at path, if set, and then with the
+// overrides that are not zero. It returns an error if the file can't be
+// loaded or if the resulting timeouts are not valid.
+func ParseTimeoutConfig(path string, overrides config.TimeoutConfig) (config.TimeoutConfig, error) {
+	timeoutConfig := config.DefaultTimeoutConfig()
+	if path != "" {
+		if err := config.LoadTimeoutConfig(path, &timeoutConfig); err != nil {
+			return timeoutConfig, err
+		}
+	}
+	config.OverrideTimeoutConfig(&timeoutConfig, overrides)
+	return timeoutConfig, config.ValidateTimeoutConfig(timeoutConfig)
+}
+
+// checkBundleInfo records the bundle version and channel of the installed
+// Gateway API CRDs, and warns if they were not released with the version of
+// the conformance tests. Failing to read them is not fatal, as the tests can
+// still run.
+func (suite *ConformanceTestSuite) checkBundleInfo(t *testing.T) {
+	info, err := kubernetes.GetBundleInfo(context.Background(), suite.Client)
+	if err != nil {
+		t.Logf("WARNING: unable to determine the installed Gateway API version: %v", err)
+		return
+	}
+	suite.bundleInfo = info
+
+	if info.Version != consts.BundleVersion() {
+		t.Logf("WARNING: the installed Gateway API CRDs are from bundle version %s (%s channel), but the conformance tests are from %s; results may not be accurate",
+			info.Version, info.Channel, consts.BundleVersion())
+	}
+}
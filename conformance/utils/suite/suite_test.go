@@ -17,10 +17,18 @@ limitations under the License.
 package suite
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/gateway-api/conformance/utils/config"
 )
 
 func TestParseSupportedFeatures(t *testing.T) {
@@ -67,3 +75,251 @@ func TestParseNamespaceLabels(t *testing.T) {
 		}
 	}
 }
+
+func TestListTests(t *testing.T) {
+	tests := []ConformanceTest{{
+		ShortName: "HTTPRouteSimpleSameNamespace",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		Manifests: []string{"tests/httproute-simple-same-namespace.yaml"},
+	}, {
+		ShortName: "HTTPRouteHeaderMatching",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute},
+		Manifests: []string{"tests/httproute-header-matching.yaml"},
+	}, {
+		ShortName: "HTTPRouteQueryParamMatching",
+		Features:  []SupportedFeature{SupportGateway, SupportHTTPRoute, SupportHTTPRouteQueryParamMatching},
+	}, {
+		ShortName: "GatewaySecretReferenceGrantAllInNamespace",
+		Features:  []SupportedFeature{SupportGateway, SupportReferenceGrant},
+	}}
+
+	testCases := []struct {
+		name        string
+		runTests    []string
+		testPattern string
+		expected    string
+	}{{
+		name: "all supported tests",
+		expected: `TEST                                       FEATURES                MANIFESTS
+HTTPRouteSimpleSameNamespace               Gateway,HTTPRoute       tests/httproute-simple-same-namespace.yaml
+HTTPRouteHeaderMatching                    Gateway,HTTPRoute       tests/httproute-header-matching.yaml
+GatewaySecretReferenceGrantAllInNamespace  Gateway,ReferenceGrant  
+`,
+	}, {
+		name:     "run tests",
+		runTests: []string{"HTTPRouteHeaderMatching", "HTTPRouteQueryParamMatching"},
+		expected: `TEST                     FEATURES           MANIFESTS
+HTTPRouteHeaderMatching  Gateway,HTTPRoute  tests/httproute-header-matching.yaml
+`,
+	}, {
+		name:        "test pattern",
+		testPattern: "^HTTPRoute.*Namespace$",
+		expected: `TEST                          FEATURES           MANIFESTS
+HTTPRouteSimpleSameNamespace  Gateway,HTTPRoute  tests/httproute-simple-same-namespace.yaml
+`,
+	}, {
+		name:        "run tests and test pattern",
+		runTests:    []string{"HTTPRouteHeaderMatching", "GatewaySecretReferenceGrantAllInNamespace"},
+		testPattern: "Namespace",
+		expected: `TEST                                       FEATURES                MANIFESTS
+GatewaySecretReferenceGrantAllInNamespace  Gateway,ReferenceGrant  
+`,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testPattern, err := ParseTestPattern(tc.testPattern)
+			if err != nil {
+				t.Fatalf("Unexpected error parsing test pattern: %v", err)
+			}
+			suite := New(Options{
+				SupportedFeatures: sets.New(SupportHTTPRoute),
+				RunTests:          tc.runTests,
+				TestPattern:       testPattern,
+			})
+
+			var out strings.Builder
+			if err := suite.ListTests(&out, tests); err != nil {
+				t.Fatalf("Unexpected error listing tests: %v", err)
+			}
+			if out.String() != tc.expected {
+				t.Errorf("Unexpected tests listed, expected:\n%s\ngot:\n%s", tc.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestParseTestPattern(t *testing.T) {
+	if re, err := ParseTestPattern(""); re != nil || err != nil {
+		t.Errorf("Expected no pattern and no error for an empty flag, got %v and %v", re, err)
+	}
+	if _, err := ParseTestPattern("("); err == nil {
+		t.Errorf("Expected an error for an invalid pattern")
+	}
+}
+
+func TestParseNamespacePrefix(t *testing.T) {
+	for _, p := range []string{"", "run-1-", strings.Repeat("a", MaxNamespacePrefixLength)} {
+		if got, err := ParseNamespacePrefix(p); got != p || err != nil {
+			t.Errorf("Expected prefix %q and no error, got %q and %v", p, got, err)
+		}
+	}
+	for _, p := range []string{strings.Repeat("a", MaxNamespacePrefixLength+1), "Run-1-", "-run-1", "run_1-"} {
+		if _, err := ParseNamespacePrefix(p); err == nil {
+			t.Errorf("Expected an error for prefix %q", p)
+		}
+	}
+
+	// The longest valid prefix keeps the names of all test Namespaces valid
+	// DNS labels, even when a test is isolated with its own prefix.
+	suite := New(Options{NamespacePrefix: strings.Repeat("a", MaxNamespacePrefixLength)})
+	isolation := isolationPrefix(ConformanceTest{ShortName: "Example"})
+	for _, ns := range []string{
+		suite.InfraNamespace(),
+		suite.AppBackendNamespace(),
+		suite.WebBackendNamespace(),
+		suite.MeshNamespace(),
+		suite.MeshConsumerNamespace(),
+	} {
+		if errs := validation.IsDNS1123Label(isolation + ns); len(errs) > 0 {
+			t.Errorf("Expected namespace %s to be valid, got %v", isolation+ns, errs)
+		}
+	}
+}
+
+func TestParseTimeoutConfig(t *testing.T) {
+	timeoutConfig, err := ParseTimeoutConfig("", config.TimeoutConfig{})
+	if err != nil {
+		t.Fatalf("Unexpected error parsing the default timeouts: %v", err)
+	}
+	if !reflect.DeepEqual(timeoutConfig, config.DefaultTimeoutConfig()) {
+		t.Errorf("Expected the default timeouts without a file or overrides, got %+v", timeoutConfig)
+	}
+
+	path := filepath.Join(t.TempDir(), "timeouts.yaml")
+	if err := os.WriteFile(path, []byte("gatewayMustHaveAddress: 5m\nnamespacesMustBeReady: 10m\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	timeoutConfig, err = ParseTimeoutConfig(path, config.TimeoutConfig{NamespacesMustBeReady: 15 * time.Minute})
+	if err != nil {
+		t.Fatalf("Unexpected error parsing the timeouts: %v", err)
+	}
+	expected := config.DefaultTimeoutConfig()
+	expected.GatewayMustHaveAddress = 5 * time.Minute
+	expected.NamespacesMustBeReady = 15 * time.Minute
+	if !reflect.DeepEqual(timeoutConfig, expected) {
+		t.Errorf("Expected the timeouts from the file and the overrides, expected %+v, got %+v", expected, timeoutConfig)
+	}
+
+	if _, err := ParseTimeoutConfig(filepath.Join(t.TempDir(), "missing.yaml"), config.TimeoutConfig{}); err == nil {
+		t.Errorf("Expected an error for a missing file")
+	}
+	if _, err := ParseTimeoutConfig("", config.TimeoutConfig{CreateTimeout: -time.Second}); err == nil {
+		t.Errorf("Expected an error for a negative timeout")
+	}
+}
+
+func TestRunParallelTests(t *testing.T) {
+	suite := New(Options{
+		SupportedFeatures: sets.New(SupportHTTPRoute),
+		ExemptFeatures:    sets.New(SupportGateway, SupportReferenceGrant),
+		ParallelTests:     2,
+	})
+
+	var (
+		lock       sync.Mutex
+		serialDone bool
+		running    int
+		maxRunning int
+		names      []string
+		namespaces = sets.New[string]()
+	)
+	parallelTest := func(t *testing.T, s *ConformanceTestSuite) {
+		lock.Lock()
+		if !serialDone {
+			t.Errorf("Parallel test %s started before serial tests completed", t.Name())
+		}
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		names = append(names, t.Name())
+		namespaces.Insert(s.Namespace("gateway-conformance-infra"))
+		if !strings.HasPrefix(s.GatewayName("same-namespace"), s.Applier.Prefix) || s.Applier.Prefix == "" {
+			t.Errorf("Expected Gateway name with a test prefix, got %s", s.GatewayName("same-namespace"))
+		}
+		lock.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		lock.Lock()
+		running--
+		lock.Unlock()
+	}
+
+	var tests []ConformanceTest
+	for _, name := range []string{"ParallelA", "ParallelB", "ParallelC", "ParallelD"} {
+		tests = append(tests, ConformanceTest{
+			ShortName: name,
+			Features:  []SupportedFeature{SupportHTTPRoute},
+			Parallel:  true,
+			Test:      parallelTest,
+		})
+	}
+	tests = append(tests, ConformanceTest{
+		ShortName: "Serial",
+		Features:  []SupportedFeature{SupportHTTPRoute},
+		Test: func(t *testing.T, s *ConformanceTestSuite) {
+			if ns := s.Namespace("gateway-conformance-infra"); ns != "gateway-conformance-infra" {
+				t.Errorf("Expected the shared Namespace for a serial test, got %s", ns)
+			}
+			lock.Lock()
+			serialDone = true
+			lock.Unlock()
+		},
+	})
+
+	t.Run("run", func(t *testing.T) {
+		suite.Run(t, tests)
+	})
+
+	if maxRunning > 2 {
+		t.Errorf("Expected at most 2 tests running in parallel, got %d", maxRunning)
+	}
+	if len(names) != 4 {
+		t.Fatalf("Expected 4 parallel tests to run, got %v", names)
+	}
+	for _, name := range names {
+		if !strings.HasPrefix(name, "TestRunParallelTests/run/Parallel/") {
+			t.Errorf("Expected parallel test %s to run as a subtest of Parallel", name)
+		}
+	}
+	if namespaces.Len() != 4 {
+		t.Errorf("Expected a different Namespace for every parallel test, got %v", sets.List(namespaces))
+	}
+}
+
+func TestNamespacePrefix(t *testing.T) {
+	suite := New(Options{NamespacePrefix: "run-1-"})
+
+	namespaces := []string{
+		suite.InfraNamespace(),
+		suite.AppBackendNamespace(),
+		suite.WebBackendNamespace(),
+		suite.MeshNamespace(),
+		suite.MeshConsumerNamespace(),
+	}
+	expected := []string{
+		"run-1-gateway-conformance-infra",
+		"run-1-gateway-conformance-app-backend",
+		"run-1-gateway-conformance-web-backend",
+		"run-1-gateway-conformance-mesh",
+		"run-1-gateway-conformance-mesh-consumer",
+	}
+	if !reflect.DeepEqual(namespaces, expected) {
+		t.Errorf("Expected namespaces %v, got %v", expected, namespaces)
+	}
+	if name := suite.GatewayName("same-namespace"); name != "same-namespace" {
+		t.Errorf("Expected the Gateway name to be unprefixed, got %s", name)
+	}
+}
@@ -90,3 +90,13 @@ docker buildx build \
     --push \
     -f docker/Dockerfile.echo \
     .
+
+echo "Building and pushing echo-basic image...${BUILDX_PLATFORMS}"
+
+docker buildx build \
+    -t ${REGISTRY}/echo-basic:${GIT_TAG} \
+    -t ${REGISTRY}/echo-basic:${VERSION_TAG} \
+    --platform ${BUILDX_PLATFORMS} \
+    --push \
+    -f docker/Dockerfile.echo-basic \
+    .
@@ -59,7 +59,31 @@ fi
 readonly COMMON_FLAGS="${VERIFY_FLAG:-} --go-header-file ${SCRIPT_ROOT}/hack/boilerplate/boilerplate.generatego.txt"
 
 echo "Generating CRDs"
-go run ./pkg/generator
+go run ./pkg/generator ${BUNDLE_VERSION:+-bundle-version "${BUNDLE_VERSION}"}
+
+# Fail early on CEL rules the API server would reject for their cost. The
+# thresholds default to the API server's limits and can be lowered to leave
+# headroom, e.g. CEL_RULE_COST_THRESHOLD=5000000.
+echo "Estimating CEL validation rule costs"
+go run ./cmd/celcost \
+  -crd-dir config/crd \
+  ${CEL_RULE_COST_THRESHOLD:+-rule-threshold "${CEL_RULE_COST_THRESHOLD}"} \
+  ${CEL_CRD_COST_THRESHOLD:+-crd-threshold "${CEL_CRD_COST_THRESHOLD}"}
+
+echo "Generating openapi schema at ${APIS_PKG}/pkg/generated/openapi"
+go run k8s.io/code-generator/cmd/openapi-gen \
+  --input-dirs "${APIS_PKG}/apis/v1alpha2,${APIS_PKG}/apis/v1beta1,k8s.io/apimachinery/pkg/apis/meta/v1,k8s.io/apimachinery/pkg/runtime,k8s.io/apimachinery/pkg/version" \
+  --output-package "${APIS_PKG}/pkg/generated/openapi" \
+  --output-file-base zz_generated.openapi \
+  --report-filename "${SCRIPT_ROOT}/hack/api-violations.list" \
+  ${COMMON_FLAGS}
+
+echo "Generating apply configurations at ${OUTPUT_PKG}/applyconfigurations"
+go run k8s.io/code-generator/cmd/applyconfiguration-gen \
+  --openapi-schema <(go run ./cmd/modelschema) \
+  --input-dirs "${APIS_PKG}/apis/v1alpha2,${APIS_PKG}/apis/v1beta1" \
+  --output-package "${OUTPUT_PKG}/applyconfigurations" \
+  ${COMMON_FLAGS}
 
 echo "Generating clientset at ${OUTPUT_PKG}/${CLIENTSET_PKG_NAME}"
 go run k8s.io/code-generator/cmd/client-gen \
@@ -67,6 +91,7 @@ go run k8s.io/code-generator/cmd/client-gen \
   --input-base "" \
   --input "${APIS_PKG}/apis/v1alpha2,${APIS_PKG}/apis/v1beta1" \
   --output-package "${OUTPUT_PKG}/${CLIENTSET_PKG_NAME}" \
+  --apply-configuration-package "${OUTPUT_PKG}/applyconfigurations" \
   ${COMMON_FLAGS}
 
 echo "Generating listers at ${OUTPUT_PKG}/listers"
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog/v2"
+
+	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const conversionReview = "ConversionReview"
+
+// conversionScheme knows the kinds of every served version and the
+// conversions between them.
+var (
+	conversionScheme = runtime.NewScheme()
+	conversionCodecs = serializer.NewCodecFactory(conversionScheme)
+)
+
+func init() {
+	utilruntime.Must(v1alpha2.Install(conversionScheme))
+	utilruntime.Must(v1beta1.Install(conversionScheme))
+}
+
+// ServeConversion parses ConversionReview requests and responds back
+// with the objects converted to the desired API version.
+func ServeConversion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("invalid method %s, only POST requests are allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.Body == nil {
+		http.Error(w, "conversion review object is missing",
+			http.StatusBadRequest)
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log500(w, err)
+		return
+	}
+
+	review := apiext.ConversionReview{}
+	err = json.Unmarshal(data, &review)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if review.Kind != conversionReview {
+		http.Error(w, "submitted object is not of kind ConversionReview", http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "conversion review request is missing", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = handleConversion(review.Request)
+	review.Request = nil
+	data, err = json.Marshal(review)
+	if err != nil {
+		log500(w, err)
+		return
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		klog.Errorf("failed to write HTTP response: %v\n", err)
+		return
+	}
+}
+
+// handleConversion converts the objects of request to the desired API
+// version. The conversion fails as a whole if any object fails to convert.
+func handleConversion(request *apiext.ConversionRequest) *apiext.ConversionResponse {
+	response := &apiext.ConversionResponse{UID: request.UID}
+
+	desired, err := schema.ParseGroupVersion(request.DesiredAPIVersion)
+	if err != nil {
+		response.Result = conversionFailure(err)
+		return response
+	}
+
+	for _, obj := range request.Objects {
+		converted, err := convertObject(obj.Raw, desired)
+		if err != nil {
+			response.ConvertedObjects = nil
+			response.Result = conversionFailure(err)
+			return response
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+
+	response.Result = meta.Status{Status: meta.StatusSuccess}
+	return response
+}
+
+// convertObject converts the JSON serialized object raw to version desired.
+func convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	obj, gvk, err := conversionCodecs.UniversalDeserializer().Decode(raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if gvk.Group != desired.Group {
+		return nil, fmt.Errorf("cannot convert %s to %s", gvk, desired)
+	}
+
+	converted, err := conversionScheme.ConvertToVersion(obj, desired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %w", gvk, desired, err)
+	}
+	return json.Marshal(converted)
+}
+
+func conversionFailure(err error) meta.Status {
+	klog.Errorf("failed to convert objects: %v\n", err)
+	return meta.Status{
+		Status:  meta.StatusFailure,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lithammer/dedent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServeConversion(t *testing.T) {
+	httpRoute := func(apiVersion string) string {
+		return `{
+			"apiVersion": "gateway.networking.k8s.io/` + apiVersion + `",
+			"kind": "HTTPRoute",
+			"metadata": {"name": "http-app-1", "namespace": "default"},
+			"spec": {
+				"parentRefs": [{"name": "my-gateway"}],
+				"rules": [{"backendRefs": [{"name": "foo", "port": 8080}]}]
+			}
+		}`
+	}
+	review := func(desiredAPIVersion string, objects ...string) string {
+		return dedent.Dedent(`{
+			"kind": "ConversionReview",
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"request": {
+				"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+				"desiredAPIVersion": "` + desiredAPIVersion + `",
+				"objects": [` + strings.Join(objects, ",") + `]
+			}
+		}`)
+	}
+
+	for _, tt := range []struct {
+		name    string
+		reqBody string
+
+		wantRespCode       int
+		wantFailureMessage string
+		wantStatus         string
+		wantMessage        string
+		wantObjects        []string
+	}{{
+		name:         "v1alpha2 to v1beta1",
+		reqBody:      review("gateway.networking.k8s.io/v1beta1", httpRoute("v1alpha2")),
+		wantRespCode: http.StatusOK,
+		wantStatus:   metav1.StatusSuccess,
+		wantObjects:  []string{httpRoute("v1beta1")},
+	}, {
+		name:         "v1beta1 to v1alpha2",
+		reqBody:      review("gateway.networking.k8s.io/v1alpha2", httpRoute("v1beta1"), httpRoute("v1alpha2")),
+		wantRespCode: http.StatusOK,
+		wantStatus:   metav1.StatusSuccess,
+		wantObjects:  []string{httpRoute("v1alpha2"), httpRoute("v1alpha2")},
+	}, {
+		name: "kind not served in the desired version",
+		reqBody: review("gateway.networking.k8s.io/v1beta1", `{
+			"apiVersion": "gateway.networking.k8s.io/v1alpha2",
+			"kind": "TCPRoute",
+			"metadata": {"name": "tcp-app-1"}
+		}`),
+		wantRespCode: http.StatusOK,
+		wantStatus:   metav1.StatusFailure,
+		wantMessage:  "failed to convert gateway.networking.k8s.io/v1alpha2, Kind=TCPRoute to gateway.networking.k8s.io/v1beta1",
+	}, {
+		name:         "other group",
+		reqBody:      review("networking.k8s.io/v1", httpRoute("v1beta1")),
+		wantRespCode: http.StatusOK,
+		wantStatus:   metav1.StatusFailure,
+		wantMessage:  "cannot convert gateway.networking.k8s.io/v1beta1, Kind=HTTPRoute to networking.k8s.io/v1",
+	}, {
+		name:               "request with empty body",
+		wantRespCode:       http.StatusBadRequest,
+		wantFailureMessage: "unexpected end of JSON input\n",
+	}, {
+		name:               "valid json but not of kind ConversionReview",
+		reqBody:            `{"kind": "AdmissionReview", "apiVersion": "admission.k8s.io/v1", "request": {}}`,
+		wantRespCode:       http.StatusBadRequest,
+		wantFailureMessage: "submitted object is not of kind ConversionReview\n",
+	}} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			res := httptest.NewRecorder()
+			req, err := http.NewRequest("POST", "", bytes.NewBuffer([]byte(tt.reqBody)))
+			require.NoError(t, err)
+			http.HandlerFunc(ServeConversion).ServeHTTP(res, req)
+
+			require.Equal(t, tt.wantRespCode, res.Code)
+			if tt.wantRespCode != http.StatusOK {
+				assert.Equal(t, tt.wantFailureMessage, res.Body.String())
+				return
+			}
+
+			var review apiext.ConversionReview
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &review))
+			require.NotNil(t, review.Response)
+			assert.EqualValues(t, "7313cd05-eddc-4150-b88c-971a0d53b2ab", review.Response.UID)
+			assert.Equal(t, tt.wantStatus, review.Response.Result.Status)
+			assert.Contains(t, review.Response.Result.Message, tt.wantMessage)
+			require.Len(t, review.Response.ConvertedObjects, len(tt.wantObjects))
+			for i, want := range tt.wantObjects {
+				assertSameObject(t, want, review.Response.ConvertedObjects[i].Raw)
+			}
+		})
+	}
+}
+
+func TestServeConversionInvalidMethod(t *testing.T) {
+	res := httptest.NewRecorder()
+	req, err := http.NewRequest("GET", "", nil)
+	require.NoError(t, err)
+	http.HandlerFunc(ServeConversion).ServeHTTP(res, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, res.Code)
+}
+
+// assertSameObject asserts that got is want, ignoring the fields that are
+// defaulted when serializing the typed object, e.g. empty status.
+func assertSameObject(t *testing.T, want string, got []byte) {
+	t.Helper()
+	var wantObj, gotObj map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(want), &wantObj))
+	require.NoError(t, json.Unmarshal(got, &gotObj))
+	for _, key := range []string{"apiVersion", "kind", "spec"} {
+		assert.Equal(t, wantObj[key], gotObj[key], key)
+	}
+	gotMeta, _ := gotObj["metadata"].(map[string]interface{})
+	wantMeta, _ := wantObj["metadata"].(map[string]interface{})
+	for key, value := range wantMeta {
+		assert.Equal(t, value, gotMeta[key], "metadata."+key)
+	}
+}
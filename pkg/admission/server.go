@@ -83,6 +83,11 @@ var (
 		Version:  v1beta1.SchemeGroupVersion.Version,
 		Resource: "httproutes",
 	}
+	v1b1GRPCRouteGVR = meta.GroupVersionResource{
+		Group:    v1beta1.SchemeGroupVersion.Group,
+		Version:  v1beta1.SchemeGroupVersion.Version,
+		Resource: "grpcroutes",
+	}
 	v1b1GatewayGVR = meta.GroupVersionResource{
 		Group:    v1beta1.SchemeGroupVersion.Group,
 		Version:  v1beta1.SchemeGroupVersion.Version,
@@ -155,6 +160,10 @@ func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionR
 		response     admission.AdmissionResponse
 		deserializer = codecs.UniversalDeserializer()
 		fieldErr     field.ErrorList
+		// The API server prunes the fields that are not part of the
+		// installed CRDs before calling the webhook, so every field that is
+		// left is part of the channel of the install.
+		channel = v1b1Validation.ExperimentalChannel
 	)
 
 	if request.Operation == admission.Delete ||
@@ -193,7 +202,7 @@ func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionR
 			return nil, err
 		}
 
-		fieldErr = v1a2Validation.ValidateHTTPRoute(&hRoute)
+		fieldErr = v1a2Validation.ValidateHTTPRoute(&hRoute, channel)
 	case v1a2GRPCRouteGVR:
 		var gRoute v1alpha2.GRPCRoute
 		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gRoute)
@@ -201,7 +210,7 @@ func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionR
 			return nil, err
 		}
 
-		fieldErr = v1a2Validation.ValidateGRPCRoute(&gRoute)
+		fieldErr = v1a2Validation.ValidateGRPCRoute(&gRoute, channel)
 	case v1b1HTTPRouteGVR:
 		var hRoute v1beta1.HTTPRoute
 		_, _, err := deserializer.Decode(request.Object.Raw, nil, &hRoute)
@@ -209,21 +218,29 @@ func handleValidation(request admission.AdmissionRequest) (*admission.AdmissionR
 			return nil, err
 		}
 
-		fieldErr = v1b1Validation.ValidateHTTPRoute(&hRoute)
+		fieldErr = v1b1Validation.ValidateHTTPRoute(&hRoute, channel)
+	case v1b1GRPCRouteGVR:
+		var gRoute v1beta1.GRPCRoute
+		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gRoute)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldErr = v1b1Validation.ValidateGRPCRoute(&gRoute, channel)
 	case v1a2GatewayGVR:
 		var gateway v1alpha2.Gateway
 		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gateway)
 		if err != nil {
 			return nil, err
 		}
-		fieldErr = v1a2Validation.ValidateGateway(&gateway)
+		fieldErr = v1a2Validation.ValidateGateway(&gateway, channel)
 	case v1b1GatewayGVR:
 		var gateway v1beta1.Gateway
 		_, _, err := deserializer.Decode(request.Object.Raw, nil, &gateway)
 		if err != nil {
 			return nil, err
 		}
-		fieldErr = v1b1Validation.ValidateGateway(&gateway)
+		fieldErr = v1b1Validation.ValidateGateway(&gateway, channel)
 	case v1a2GatewayClassGVR:
 		// runs only for updates
 		if request.Operation != admission.Update {
@@ -433,6 +433,101 @@ func TestServeHTTPSubmissions(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "valid v1beta1 GRPCRoute resource",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1beta1",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1beta1",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"rules": [
+										{
+											"matches": [
+												{
+													"method": {
+														"service": "com.example.User",
+														"method": "Login"
+													}
+												}
+											],
+											"backendRefs": [
+												{
+													"name": "my-service1",
+													"port": 50051
+												}
+											]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: true,
+					Result:  &metav1.Status{},
+				},
+			},
+			{
+				name: "v1beta1 GRPCRoute resource with an invalid method name results in an error",
+				reqBody: dedent.Dedent(`{
+						"kind": "AdmissionReview",
+						"apiVersion": "` + apiVersion + `",
+						"request": {
+							"uid": "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+							"resource": {
+								"group": "gateway.networking.k8s.io",
+								"version": "v1beta1",
+								"resource": "grpcroutes"
+							},
+							"object": {
+								"kind": "GRPCRoute",
+								"apiVersion": "gateway.networking.k8s.io/v1beta1",
+								"metadata": {
+									"name": "grpc-app-1"
+								},
+								"spec": {
+									"rules": [
+										{
+											"matches": [
+												{
+													"method": {
+														"service": "com.example.User",
+														"method": "Log/in"
+													}
+												}
+											]
+										}
+									]
+								}
+							},
+						"operation": "CREATE"
+						}
+					}`),
+				wantRespCode: http.StatusOK,
+				wantSuccessResponse: admission.AdmissionResponse{
+					UID:     "7313cd05-eddc-4150-b88c-971a0d53b2ab",
+					Allowed: false,
+					Result: &metav1.Status{
+						Code:    400,
+						Message: `spec.rules[0].matches[0].method: Invalid value: "Log/in": must only contain valid characters (matching ^[A-Za-z_][A-Za-z_0-9]*$)`,
+					},
+				},
+			},
 			{
 				name: "unknown resource under networking.x-k8s.io",
 				reqBody: dedent.Dedent(`{
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package celcost estimates the cost of the x-kubernetes-validations CEL
+// rules of a CRD the same way the API server does when the CRD is installed,
+// so that rules exceeding the cost limits are caught before release.
+package celcost
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/validation"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+)
+
+const (
+	// RuleCostLimit is the largest estimated cost the API server allows for
+	// a single rule.
+	RuleCostLimit uint64 = validation.StaticEstimatedCostLimit
+
+	// CRDCostLimit is the largest estimated total cost the API server allows
+	// for all the rules of a CRD version.
+	CRDCostLimit uint64 = validation.StaticEstimatedCRDCostLimit
+)
+
+// RuleCost is the estimated cost of a single rule or message expression.
+type RuleCost struct {
+	// Path is the path of the schema node the rule is declared on.
+	Path string `json:"path"`
+	// Rule is the CEL expression.
+	Rule string `json:"rule"`
+	// Cost is the estimated worst case cost of evaluating the expression for
+	// every instance of the schema node in an object.
+	Cost uint64 `json:"cost"`
+	// Cardinality is the worst case number of instances of the schema node
+	// in an object, math.MaxUint64 if some parent list or map is unbounded.
+	Cardinality uint64 `json:"cardinality"`
+}
+
+// VersionCost is the estimated cost of all the rules of a CRD version.
+type VersionCost struct {
+	CRD     string     `json:"crd"`
+	Version string     `json:"version"`
+	Total   uint64     `json:"total"`
+	Rules   []RuleCost `json:"rules"`
+}
+
+// Estimate returns the estimated cost of the rules of every version of crd,
+// with the rules of each version sorted by descending cost.
+func Estimate(crd *apiext.CustomResourceDefinition) ([]VersionCost, error) {
+	var costs []VersionCost
+	for _, version := range crd.Spec.Versions {
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		schema := &apiextensions.JSONSchemaProps{}
+		if err := apiext.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(version.Schema.OpenAPIV3Schema, schema, nil); err != nil {
+			return nil, fmt.Errorf("%s/%s: converting schema: %w", crd.Name, version.Name, err)
+		}
+
+		cost := VersionCost{CRD: crd.Name, Version: version.Name}
+		if err := estimateSchema(schema, validation.RootCELContext(schema), nil, &cost); err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", crd.Name, version.Name, err)
+		}
+		sort.SliceStable(cost.Rules, func(i, j int) bool {
+			return cost.Rules[i].Cost > cost.Rules[j].Cost
+		})
+		costs = append(costs, cost)
+	}
+	return costs, nil
+}
+
+// estimateSchema adds the cost of the rules of schema and its children to
+// cost, walking the schema like the API server's CRD validation.
+func estimateSchema(schema *apiextensions.JSONSchemaProps, celContext *validation.CELSchemaContext, path *field.Path, cost *VersionCost) error {
+	if len(schema.XValidations) > 0 {
+		typeInfo, err := celContext.TypeInfo()
+		if err != nil {
+			return fmt.Errorf("%s: constructing type information: %w", pathString(path), err)
+		}
+		if typeInfo == nil {
+			return fmt.Errorf("%s: missing type information", pathString(path))
+		}
+		results, err := cel.Compile(typeInfo.Schema, typeInfo.DeclType, celconfig.PerCallLimit)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pathString(path), err)
+		}
+		for i, result := range results {
+			rule := schema.XValidations[i]
+			if result.Error != nil {
+				return fmt.Errorf("%s: compiling rule %q: %s", pathString(path), rule.Rule, result.Error.Detail)
+			}
+			cardinality := result.MaxCardinality
+			if celContext.MaxCardinality != nil {
+				cardinality = *celContext.MaxCardinality
+			}
+			cost.observe(RuleCost{
+				Path:        pathString(path),
+				Rule:        rule.Rule,
+				Cost:        multiply(result.MaxCost, cardinality),
+				Cardinality: cardinality,
+			})
+			if result.MessageExpression != nil {
+				cost.observe(RuleCost{
+					Path:        pathString(path),
+					Rule:        rule.MessageExpression,
+					Cost:        result.MessageExpressionMaxCost,
+					Cardinality: cardinality,
+				})
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		prop := schema.Properties[name]
+		if err := estimateSchema(&prop, celContext.ChildPropertyContext(&prop, name), path.Child(name), cost); err != nil {
+			return err
+		}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		props := schema.AdditionalProperties.Schema
+		if err := estimateSchema(props, celContext.ChildAdditionalPropertiesContext(props), path.Key("*"), cost); err != nil {
+			return err
+		}
+	}
+	if schema.Items != nil && schema.Items.Schema != nil {
+		items := schema.Items.Schema
+		if err := estimateSchema(items, celContext.ChildItemsContext(items), withItems(path), cost); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *VersionCost) observe(rule RuleCost) {
+	c.Rules = append(c.Rules, rule)
+	c.Total = add(c.Total, rule.Cost)
+}
+
+// multiply and add saturate at math.MaxUint64 like the API server.
+func multiply(cost, cardinality uint64) uint64 {
+	if cost == 0 {
+		return 0
+	}
+	if math.MaxUint64/cost < cardinality {
+		return math.MaxUint64
+	}
+	return cost * cardinality
+}
+
+func add(a, b uint64) uint64 {
+	if math.MaxUint64-a < b {
+		return math.MaxUint64
+	}
+	return a + b
+}
+
+// withItems returns the path of the items of the list at path, e.g.
+// spec.rules[*].
+func withItems(path *field.Path) *field.Path {
+	if path == nil {
+		return field.NewPath("[*]")
+	}
+	return field.NewPath(path.String() + "[*]")
+}
+
+func pathString(path *field.Path) string {
+	if path == nil {
+		return "<root>"
+	}
+	return path.String()
+}
+
+func sortedKeys(m map[string]apiextensions.JSONSchemaProps) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package celcost
+
+import (
+	"strings"
+	"testing"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
+	"sigs.k8s.io/gateway-api/pkg/crdcompat"
+)
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
+
+func crdWithSpec(spec apiext.JSONSchemaProps) *apiext.CustomResourceDefinition {
+	return &apiext.CustomResourceDefinition{
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Versions: []apiext.CustomResourceDefinitionVersion{{
+				Name: "v1",
+				Schema: &apiext.CustomResourceValidation{
+					OpenAPIV3Schema: &apiext.JSONSchemaProps{
+						Type: "object",
+						Properties: map[string]apiext.JSONSchemaProps{
+							"spec": spec,
+						},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func namesList(maxItems *int64, rules ...apiext.ValidationRule) apiext.JSONSchemaProps {
+	return apiext.JSONSchemaProps{
+		Type: "object",
+		Properties: map[string]apiext.JSONSchemaProps{
+			"names": {
+				Type:     "array",
+				MaxItems: maxItems,
+				Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+					Type:      "string",
+					MaxLength: ptrTo[int64](63),
+				}},
+				XValidations: rules,
+			},
+		},
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	uniqueNames := apiext.ValidationRule{Rule: "self.all(n1, self.exists_one(n2, n1 == n2))"}
+
+	tests := []struct {
+		name      string
+		spec      apiext.JSONSchemaProps
+		wantRules int
+		wantPath  string
+		check     func(t *testing.T, cost VersionCost)
+		wantErr   string
+	}{{
+		name:      "bounded list",
+		spec:      namesList(ptrTo[int64](16), uniqueNames),
+		wantRules: 1,
+		wantPath:  "spec.names",
+		check: func(t *testing.T, cost VersionCost) {
+			if cost.Rules[0].Cost == 0 || cost.Rules[0].Cost > RuleCostLimit {
+				t.Errorf("expected cost within limit, got %d", cost.Rules[0].Cost)
+			}
+		},
+	}, {
+		name:      "unbounded list exceeds limit",
+		spec:      namesList(nil, uniqueNames),
+		wantRules: 1,
+		wantPath:  "spec.names",
+		check: func(t *testing.T, cost VersionCost) {
+			if cost.Rules[0].Cost <= RuleCostLimit {
+				t.Errorf("expected cost above limit %d, got %d", RuleCostLimit, cost.Rules[0].Cost)
+			}
+		},
+	}, {
+		name:      "message expression is counted",
+		spec:      namesList(ptrTo[int64](16), apiext.ValidationRule{Rule: "size(self) > 0", MessageExpression: "'got ' + string(size(self))"}),
+		wantRules: 2,
+		wantPath:  "spec.names",
+	}, {
+		name: "rule on list items is multiplied by cardinality",
+		spec: apiext.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiext.JSONSchemaProps{
+				"names": {
+					Type:     "array",
+					MaxItems: ptrTo[int64](8),
+					Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+						Type:         "string",
+						MaxLength:    ptrTo[int64](63),
+						XValidations: []apiext.ValidationRule{{Rule: "self != 'invalid'"}},
+					}},
+				},
+			},
+		},
+		wantRules: 1,
+		wantPath:  "spec.names[*]",
+		check: func(t *testing.T, cost VersionCost) {
+			if cost.Rules[0].Cardinality != 8 {
+				t.Errorf("expected cardinality 8, got %d", cost.Rules[0].Cardinality)
+			}
+		},
+	}, {
+		name:    "invalid rule",
+		spec:    namesList(ptrTo[int64](16), apiext.ValidationRule{Rule: "self.unknown()"}),
+		wantErr: "spec.names: compiling rule",
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			costs, err := Estimate(crdWithSpec(tc.spec))
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(costs) != 1 {
+				t.Fatalf("expected 1 version, got %d", len(costs))
+			}
+			cost := costs[0]
+			if len(cost.Rules) != tc.wantRules {
+				t.Fatalf("expected %d rules, got %d: %+v", tc.wantRules, len(cost.Rules), cost.Rules)
+			}
+			var total uint64
+			for _, rule := range cost.Rules {
+				if rule.Path != tc.wantPath {
+					t.Errorf("expected path %q, got %q", tc.wantPath, rule.Path)
+				}
+				total = add(total, rule.Cost)
+			}
+			if cost.Total != total {
+				t.Errorf("expected total %d, got %d", total, cost.Total)
+			}
+			if tc.check != nil {
+				tc.check(t, cost)
+			}
+		})
+	}
+}
+
+// TestGeneratedCRDs ensures that the rules of the generated CRDs stay within
+// the API server's cost limits.
+func TestGeneratedCRDs(t *testing.T) {
+	bundle, err := crdcompat.LoadDir("../../config/crd")
+	if err != nil {
+		t.Fatalf("loading CRDs: %v", err)
+	}
+	for channel, crds := range bundle.CRDs {
+		for name, crd := range crds {
+			costs, err := Estimate(crd)
+			if err != nil {
+				t.Errorf("%s (%s): %v", name, channel, err)
+				continue
+			}
+			for _, cost := range costs {
+				if cost.Total > CRDCostLimit {
+					t.Errorf("%s/%s (%s): total cost %d exceeds limit %d", name, cost.Version, channel, cost.Total, CRDCostLimit)
+				}
+				for _, rule := range cost.Rules {
+					if rule.Cost > RuleCostLimit {
+						t.Errorf("%s/%s (%s): %s: cost %d of rule %q exceeds limit %d", name, cost.Version, channel, rule.Path, rule.Cost, rule.Rule, RuleCostLimit)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// HTTPRouteFilterApplyConfiguration represents an declarative configuration of the HTTPRouteFilter type for use
+// with apply.
+type HTTPRouteFilterApplyConfiguration struct {
+	Type                   *v1beta1.HTTPRouteFilterType                 `json:"type,omitempty"`
+	RequestHeaderModifier  *HTTPHeaderFilterApplyConfiguration          `json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *HTTPHeaderFilterApplyConfiguration          `json:"responseHeaderModifier,omitempty"`
+	RequestMirror          *HTTPRequestMirrorFilterApplyConfiguration   `json:"requestMirror,omitempty"`
+	RequestRedirect        *HTTPRequestRedirectFilterApplyConfiguration `json:"requestRedirect,omitempty"`
+	URLRewrite             *HTTPURLRewriteFilterApplyConfiguration      `json:"urlRewrite,omitempty"`
+	ExtensionRef           *LocalObjectReferenceApplyConfiguration      `json:"extensionRef,omitempty"`
+}
+
+// HTTPRouteFilterApplyConfiguration constructs an declarative configuration of the HTTPRouteFilter type for use with
+// apply.
+func HTTPRouteFilter() *HTTPRouteFilterApplyConfiguration {
+	return &HTTPRouteFilterApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithType(value v1beta1.HTTPRouteFilterType) *HTTPRouteFilterApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithRequestHeaderModifier sets the RequestHeaderModifier field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestHeaderModifier field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithRequestHeaderModifier(value *HTTPHeaderFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.RequestHeaderModifier = value
+	return b
+}
+
+// WithResponseHeaderModifier sets the ResponseHeaderModifier field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResponseHeaderModifier field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithResponseHeaderModifier(value *HTTPHeaderFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.ResponseHeaderModifier = value
+	return b
+}
+
+// WithRequestMirror sets the RequestMirror field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestMirror field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithRequestMirror(value *HTTPRequestMirrorFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.RequestMirror = value
+	return b
+}
+
+// WithRequestRedirect sets the RequestRedirect field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestRedirect field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithRequestRedirect(value *HTTPRequestRedirectFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.RequestRedirect = value
+	return b
+}
+
+// WithURLRewrite sets the URLRewrite field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URLRewrite field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithURLRewrite(value *HTTPURLRewriteFilterApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.URLRewrite = value
+	return b
+}
+
+// WithExtensionRef sets the ExtensionRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExtensionRef field is set to the value of the last call.
+func (b *HTTPRouteFilterApplyConfiguration) WithExtensionRef(value *LocalObjectReferenceApplyConfiguration) *HTTPRouteFilterApplyConfiguration {
+	b.ExtensionRef = value
+	return b
+}
@@ -0,0 +1,61 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// LocalParametersReferenceApplyConfiguration represents an declarative configuration of the LocalParametersReference type for use
+// with apply.
+type LocalParametersReferenceApplyConfiguration struct {
+	Group *v1beta1.Group `json:"group,omitempty"`
+	Kind  *v1beta1.Kind  `json:"kind,omitempty"`
+	Name  *string        `json:"name,omitempty"`
+}
+
+// LocalParametersReferenceApplyConfiguration constructs an declarative configuration of the LocalParametersReference type for use with
+// apply.
+func LocalParametersReference() *LocalParametersReferenceApplyConfiguration {
+	return &LocalParametersReferenceApplyConfiguration{}
+}
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *LocalParametersReferenceApplyConfiguration) WithGroup(value v1beta1.Group) *LocalParametersReferenceApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *LocalParametersReferenceApplyConfiguration) WithKind(value v1beta1.Kind) *LocalParametersReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LocalParametersReferenceApplyConfiguration) WithName(value string) *LocalParametersReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
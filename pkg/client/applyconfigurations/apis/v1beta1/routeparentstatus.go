@@ -0,0 +1,64 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apisv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// RouteParentStatusApplyConfiguration represents an declarative configuration of the RouteParentStatus type for use
+// with apply.
+type RouteParentStatusApplyConfiguration struct {
+	ParentRef      *ParentReferenceApplyConfiguration `json:"parentRef,omitempty"`
+	ControllerName *apisv1beta1.GatewayController     `json:"controllerName,omitempty"`
+	Conditions     []v1.Condition                     `json:"conditions,omitempty"`
+}
+
+// RouteParentStatusApplyConfiguration constructs an declarative configuration of the RouteParentStatus type for use with
+// apply.
+func RouteParentStatus() *RouteParentStatusApplyConfiguration {
+	return &RouteParentStatusApplyConfiguration{}
+}
+
+// WithParentRef sets the ParentRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ParentRef field is set to the value of the last call.
+func (b *RouteParentStatusApplyConfiguration) WithParentRef(value *ParentReferenceApplyConfiguration) *RouteParentStatusApplyConfiguration {
+	b.ParentRef = value
+	return b
+}
+
+// WithControllerName sets the ControllerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ControllerName field is set to the value of the last call.
+func (b *RouteParentStatusApplyConfiguration) WithControllerName(value apisv1beta1.GatewayController) *RouteParentStatusApplyConfiguration {
+	b.ControllerName = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *RouteParentStatusApplyConfiguration) WithConditions(values ...v1.Condition) *RouteParentStatusApplyConfiguration {
+	for i := range values {
+		b.Conditions = append(b.Conditions, values[i])
+	}
+	return b
+}
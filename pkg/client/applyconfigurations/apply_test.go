@@ -0,0 +1,120 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applyconfigurations_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/managedfields/managedfieldstest"
+	"sigs.k8s.io/structured-merge-diff/v4/typed"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	applyv1beta1 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/internal"
+)
+
+// httpRouteConverter converts HTTPRoutes with the schema of the apply
+// configurations, so that they are merged the way the API server merges them.
+type httpRouteConverter struct{}
+
+func (httpRouteConverter) ObjectToTyped(obj runtime.Object) (*typed.TypedValue, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	return internal.Parser().Type("io.k8s.sigs.gateway-api.apis.v1beta1.HTTPRoute").FromUnstructured(u)
+}
+
+func (httpRouteConverter) TypedToObject(value *typed.TypedValue) (runtime.Object, error) {
+	u, ok := value.AsValue().Unstructured().(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected an object, got %T", value.AsValue().Unstructured())
+	}
+	return &unstructured.Unstructured{Object: u}, nil
+}
+
+// TestApplyHTTPRouteStatusParents checks how server-side apply merges the
+// parents of a Route written by two controllers. The list is atomic, so a
+// controller can't drop the entries of another one without forcing, and has to
+// apply them along with its own.
+func TestApplyHTTPRouteStatusParents(t *testing.T) {
+	manager := managedfieldstest.NewTestFieldManagerSubresource(httpRouteConverter{}, v1beta1.SchemeGroupVersion.WithKind("HTTPRoute"), "status")
+
+	parentStatus := func(gateway string, controller v1beta1.GatewayController, status metav1.ConditionStatus) *applyv1beta1.RouteParentStatusApplyConfiguration {
+		return applyv1beta1.RouteParentStatus().
+			WithParentRef(applyv1beta1.ParentReference().WithName(v1beta1.ObjectName(gateway))).
+			WithControllerName(controller).
+			WithConditions(metav1.Condition{
+				Type:               string(v1beta1.RouteConditionAccepted),
+				Status:             status,
+				Reason:             string(v1beta1.RouteReasonAccepted),
+				LastTransitionTime: metav1.Unix(0, 0),
+			})
+	}
+	apply := func(fieldManager string, force bool, parents ...*applyv1beta1.RouteParentStatusApplyConfiguration) error {
+		t.Helper()
+		route := applyv1beta1.HTTPRoute("route", "default").
+			WithStatus(applyv1beta1.HTTPRouteStatus().WithParents(parents...))
+		data, err := json.Marshal(route)
+		require.NoError(t, err)
+		obj := &unstructured.Unstructured{}
+		require.NoError(t, json.Unmarshal(data, &obj.Object))
+		return manager.Apply(obj, fieldManager, force)
+	}
+	liveControllers := func() map[v1beta1.GatewayController]metav1.ConditionStatus {
+		t.Helper()
+		route := &v1beta1.HTTPRoute{}
+		u := manager.Live().(*unstructured.Unstructured)
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, route))
+		controllers := map[v1beta1.GatewayController]metav1.ConditionStatus{}
+		for _, parent := range route.Status.Parents {
+			controllers[parent.ControllerName] = parent.Conditions[0].Status
+		}
+		return controllers
+	}
+
+	a := parentStatus("gateway-a", "example.com/controller-a", metav1.ConditionUnknown)
+	require.NoError(t, apply("controller-a", false, a))
+
+	b := parentStatus("gateway-b", "example.com/controller-b", metav1.ConditionTrue)
+	err := apply("controller-b", false, b)
+	require.Error(t, err, "applying only its own entry must conflict with the entry of controller-a")
+	assert.Contains(t, err.Error(), "controller-a")
+	assert.Equal(t, map[v1beta1.GatewayController]metav1.ConditionStatus{
+		"example.com/controller-a": metav1.ConditionUnknown,
+	}, liveControllers())
+
+	require.NoError(t, apply("controller-b", true, a, b))
+	assert.Equal(t, map[v1beta1.GatewayController]metav1.ConditionStatus{
+		"example.com/controller-a": metav1.ConditionUnknown,
+		"example.com/controller-b": metav1.ConditionTrue,
+	}, liveControllers())
+
+	a = parentStatus("gateway-a", "example.com/controller-a", metav1.ConditionTrue)
+	require.NoError(t, apply("controller-a", true, a, b))
+	assert.Equal(t, map[v1beta1.GatewayController]metav1.ConditionStatus{
+		"example.com/controller-a": metav1.ConditionTrue,
+		"example.com/controller-b": metav1.ConditionTrue,
+	}, liveControllers())
+}
@@ -0,0 +1,183 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfigurations
+
+import (
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	v1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	apisv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1alpha2"
+	apisv1beta1 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1beta1"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=gateway.networking.k8s.io, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithKind("Gateway"):
+		return &apisv1alpha2.GatewayApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("GatewayClass"):
+		return &apisv1alpha2.GatewayClassApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("GRPCRoute"):
+		return &apisv1alpha2.GRPCRouteApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("HTTPRoute"):
+		return &apisv1alpha2.HTTPRouteApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ReferenceGrant"):
+		return &apisv1alpha2.ReferenceGrantApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TCPRoute"):
+		return &apisv1alpha2.TCPRouteApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TCPRouteRule"):
+		return &apisv1alpha2.TCPRouteRuleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TCPRouteSpec"):
+		return &apisv1alpha2.TCPRouteSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TCPRouteStatus"):
+		return &apisv1alpha2.TCPRouteStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TLSRoute"):
+		return &apisv1alpha2.TLSRouteApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TLSRouteRule"):
+		return &apisv1alpha2.TLSRouteRuleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TLSRouteSpec"):
+		return &apisv1alpha2.TLSRouteSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("TLSRouteStatus"):
+		return &apisv1alpha2.TLSRouteStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("UDPRoute"):
+		return &apisv1alpha2.UDPRouteApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("UDPRouteRule"):
+		return &apisv1alpha2.UDPRouteRuleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("UDPRouteSpec"):
+		return &apisv1alpha2.UDPRouteSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("UDPRouteStatus"):
+		return &apisv1alpha2.UDPRouteStatusApplyConfiguration{}
+
+		// Group=gateway.networking.k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("AllowedRoutes"):
+		return &apisv1beta1.AllowedRoutesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BackendObjectReference"):
+		return &apisv1beta1.BackendObjectReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("BackendRef"):
+		return &apisv1beta1.BackendRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CommonRouteSpec"):
+		return &apisv1beta1.CommonRouteSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Gateway"):
+		return &apisv1beta1.GatewayApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayAddress"):
+		return &apisv1beta1.GatewayAddressApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayClass"):
+		return &apisv1beta1.GatewayClassApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayClassSpec"):
+		return &apisv1beta1.GatewayClassSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayClassStatus"):
+		return &apisv1beta1.GatewayClassStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayInfrastructure"):
+		return &apisv1beta1.GatewayInfrastructureApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewaySpec"):
+		return &apisv1beta1.GatewaySpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayStatus"):
+		return &apisv1beta1.GatewayStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayStatusAddress"):
+		return &apisv1beta1.GatewayStatusAddressApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GatewayTLSConfig"):
+		return &apisv1beta1.GatewayTLSConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCBackendRef"):
+		return &apisv1beta1.GRPCBackendRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCHeaderMatch"):
+		return &apisv1beta1.GRPCHeaderMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCMethodMatch"):
+		return &apisv1beta1.GRPCMethodMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRoute"):
+		return &apisv1beta1.GRPCRouteApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRouteFilter"):
+		return &apisv1beta1.GRPCRouteFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRouteMatch"):
+		return &apisv1beta1.GRPCRouteMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRouteRule"):
+		return &apisv1beta1.GRPCRouteRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRouteSpec"):
+		return &apisv1beta1.GRPCRouteSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("GRPCRouteStatus"):
+		return &apisv1beta1.GRPCRouteStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPBackendRef"):
+		return &apisv1beta1.HTTPBackendRefApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPHeader"):
+		return &apisv1beta1.HTTPHeaderApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPHeaderFilter"):
+		return &apisv1beta1.HTTPHeaderFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPHeaderMatch"):
+		return &apisv1beta1.HTTPHeaderMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPPathMatch"):
+		return &apisv1beta1.HTTPPathMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPPathModifier"):
+		return &apisv1beta1.HTTPPathModifierApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPQueryParamMatch"):
+		return &apisv1beta1.HTTPQueryParamMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRequestMirrorFilter"):
+		return &apisv1beta1.HTTPRequestMirrorFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRequestRedirectFilter"):
+		return &apisv1beta1.HTTPRequestRedirectFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRoute"):
+		return &apisv1beta1.HTTPRouteApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteFilter"):
+		return &apisv1beta1.HTTPRouteFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteMatch"):
+		return &apisv1beta1.HTTPRouteMatchApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteRetry"):
+		return &apisv1beta1.HTTPRouteRetryApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteRule"):
+		return &apisv1beta1.HTTPRouteRuleApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteSpec"):
+		return &apisv1beta1.HTTPRouteSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPRouteStatus"):
+		return &apisv1beta1.HTTPRouteStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HTTPURLRewriteFilter"):
+		return &apisv1beta1.HTTPURLRewriteFilterApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("Listener"):
+		return &apisv1beta1.ListenerApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ListenerStatus"):
+		return &apisv1beta1.ListenerStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LocalObjectReference"):
+		return &apisv1beta1.LocalObjectReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LocalParametersReference"):
+		return &apisv1beta1.LocalParametersReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParametersReference"):
+		return &apisv1beta1.ParametersReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ParentReference"):
+		return &apisv1beta1.ParentReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReferenceGrant"):
+		return &apisv1beta1.ReferenceGrantApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReferenceGrantFrom"):
+		return &apisv1beta1.ReferenceGrantFromApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReferenceGrantSpec"):
+		return &apisv1beta1.ReferenceGrantSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ReferenceGrantTo"):
+		return &apisv1beta1.ReferenceGrantToApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RouteGroupKind"):
+		return &apisv1beta1.RouteGroupKindApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RouteNamespaces"):
+		return &apisv1beta1.RouteNamespacesApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RouteParentStatus"):
+		return &apisv1beta1.RouteParentStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RouteStatus"):
+		return &apisv1beta1.RouteStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SecretObjectReference"):
+		return &apisv1beta1.SecretObjectReferenceApplyConfiguration{}
+
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	apisv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1alpha2"
 )
 
 // FakeGateways implements GatewayInterface
@@ -139,3 +142,48 @@ func (c *FakeGateways) Patch(ctx context.Context, name string, pt types.PatchTyp
 	}
 	return obj.(*v1alpha2.Gateway), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gateway.
+func (c *FakeGateways) Apply(ctx context.Context, gateway *apisv1alpha2.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(gatewaysResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.Gateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Gateway), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeGateways) ApplyStatus(ctx context.Context, gateway *apisv1alpha2.GatewayApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.Gateway, err error) {
+	if gateway == nil {
+		return nil, fmt.Errorf("gateway provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, err
+	}
+	name := gateway.Name
+	if name == nil {
+		return nil, fmt.Errorf("gateway.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(gatewaysResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha2.Gateway{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.Gateway), err
+}
@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	apisv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1alpha2"
 )
 
 // FakeGatewayClasses implements GatewayClassInterface
@@ -130,3 +133,46 @@ func (c *FakeGatewayClasses) Patch(ctx context.Context, name string, pt types.Pa
 	}
 	return obj.(*v1alpha2.GatewayClass), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied gatewayClass.
+func (c *FakeGatewayClasses) Apply(ctx context.Context, gatewayClass *apisv1alpha2.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(gatewayclassesResource, *name, types.ApplyPatchType, data), &v1alpha2.GatewayClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.GatewayClass), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeGatewayClasses) ApplyStatus(ctx context.Context, gatewayClass *apisv1alpha2.GatewayClassApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.GatewayClass, err error) {
+	if gatewayClass == nil {
+		return nil, fmt.Errorf("gatewayClass provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(gatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	name := gatewayClass.Name
+	if name == nil {
+		return nil, fmt.Errorf("gatewayClass.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(gatewayclassesResource, *name, types.ApplyPatchType, data, "status"), &v1alpha2.GatewayClass{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.GatewayClass), err
+}
@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	apisv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1alpha2"
 )
 
 // FakeHTTPRoutes implements HTTPRouteInterface
@@ -139,3 +142,48 @@ func (c *FakeHTTPRoutes) Patch(ctx context.Context, name string, pt types.PatchT
 	}
 	return obj.(*v1alpha2.HTTPRoute), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied hTTPRoute.
+func (c *FakeHTTPRoutes) Apply(ctx context.Context, hTTPRoute *apisv1alpha2.HTTPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.HTTPRoute, err error) {
+	if hTTPRoute == nil {
+		return nil, fmt.Errorf("hTTPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(hTTPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := hTTPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("hTTPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(httproutesResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.HTTPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HTTPRoute), err
+}
+
+// ApplyStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+func (c *FakeHTTPRoutes) ApplyStatus(ctx context.Context, hTTPRoute *apisv1alpha2.HTTPRouteApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.HTTPRoute, err error) {
+	if hTTPRoute == nil {
+		return nil, fmt.Errorf("hTTPRoute provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(hTTPRoute)
+	if err != nil {
+		return nil, err
+	}
+	name := hTTPRoute.Name
+	if name == nil {
+		return nil, fmt.Errorf("hTTPRoute.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(httproutesResource, c.ns, *name, types.ApplyPatchType, data, "status"), &v1alpha2.HTTPRoute{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.HTTPRoute), err
+}
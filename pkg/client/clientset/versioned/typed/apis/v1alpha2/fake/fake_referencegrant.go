@@ -20,6 +20,8 @@ package fake
 
 import (
 	"context"
+	json "encoding/json"
+	"fmt"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	labels "k8s.io/apimachinery/pkg/labels"
@@ -27,6 +29,7 @@ import (
 	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 	v1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	apisv1alpha2 "sigs.k8s.io/gateway-api/pkg/client/applyconfigurations/apis/v1alpha2"
 )
 
 // FakeReferenceGrants implements ReferenceGrantInterface
@@ -127,3 +130,25 @@ func (c *FakeReferenceGrants) Patch(ctx context.Context, name string, pt types.P
 	}
 	return obj.(*v1alpha2.ReferenceGrant), err
 }
+
+// Apply takes the given apply declarative configuration, applies it and returns the applied referenceGrant.
+func (c *FakeReferenceGrants) Apply(ctx context.Context, referenceGrant *apisv1alpha2.ReferenceGrantApplyConfiguration, opts v1.ApplyOptions) (result *v1alpha2.ReferenceGrant, err error) {
+	if referenceGrant == nil {
+		return nil, fmt.Errorf("referenceGrant provided to Apply must not be nil")
+	}
+	data, err := json.Marshal(referenceGrant)
+	if err != nil {
+		return nil, err
+	}
+	name := referenceGrant.Name
+	if name == nil {
+		return nil, fmt.Errorf("referenceGrant.Name must be provided to Apply")
+	}
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(referencegrantsResource, c.ns, *name, types.ApplyPatchType, data), &v1alpha2.ReferenceGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha2.ReferenceGrant), err
+}
@@ -28,6 +28,7 @@ import (
 
 type GatewayV1beta1Interface interface {
 	RESTClient() rest.Interface
+	GRPCRoutesGetter
 	GatewaysGetter
 	GatewayClassesGetter
 	HTTPRoutesGetter
@@ -39,6 +40,10 @@ type GatewayV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *GatewayV1beta1Client) GRPCRoutes(namespace string) GRPCRouteInterface {
+	return newGRPCRoutes(c, namespace)
+}
+
 func (c *GatewayV1beta1Client) Gateways(namespace string) GatewayInterface {
 	return newGateways(c, namespace)
 }
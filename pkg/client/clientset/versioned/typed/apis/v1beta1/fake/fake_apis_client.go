@@ -28,6 +28,10 @@ type FakeGatewayV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeGatewayV1beta1) GRPCRoutes(namespace string) v1beta1.GRPCRouteInterface {
+	return &FakeGRPCRoutes{c, namespace}
+}
+
 func (c *FakeGatewayV1beta1) Gateways(namespace string) v1beta1.GatewayInterface {
 	return &FakeGateways{c, namespace}
 }
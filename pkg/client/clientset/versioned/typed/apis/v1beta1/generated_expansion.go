@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1beta1
 
+type GRPCRouteExpansion interface{}
+
 type GatewayExpansion interface{}
 
 type GatewayClassExpansion interface{}
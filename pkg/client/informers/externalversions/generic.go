@@ -72,6 +72,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1alpha2().UDPRoutes().Informer()}, nil
 
 		// Group=gateway.networking.k8s.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("grpcroutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1beta1().GRPCRoutes().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("gateways"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1beta1().Gateways().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("gatewayclasses"):
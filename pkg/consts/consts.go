@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package consts contains the constants shared by the Gateway API CRDs, the
+// tools that generate and check them, and the conformance tests.
+package consts
+
+import (
+	_ "embed"
+	"strings"
+)
+
+const (
+	// BundleVersionAnnotation is the annotation of the Gateway API CRDs that
+	// records the bundle version they were released in.
+	BundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
+
+	// ChannelAnnotation is the annotation of the Gateway API CRDs that records
+	// the release channel they belong to, standard or experimental.
+	ChannelAnnotation = "gateway.networking.k8s.io/channel"
+)
+
+// versionFile is the content of the VERSION file, which is updated during the
+// release process.
+//
+//go:embed VERSION
+var versionFile string
+
+// version overrides the VERSION file when set at build time with
+//
+//	-ldflags "-X sigs.k8s.io/gateway-api/pkg/consts.version=v1.0.0"
+var version string
+
+// BundleVersion returns the Gateway API bundle version of this tree: the
+// version set with -ldflags if any, otherwise the content of the VERSION file.
+func BundleVersion() string {
+	if version != "" {
+		return version
+	}
+	return strings.TrimSpace(versionFile)
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consts
+
+import (
+	"regexp"
+	"testing"
+)
+
+var semver = regexp.MustCompile(`^v\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?$`)
+
+func TestBundleVersion(t *testing.T) {
+	if got := BundleVersion(); !semver.MatchString(got) {
+		t.Errorf("expected a semantic version with a v prefix, got %q", got)
+	}
+
+	version = "v9.9.9"
+	defer func() { version = "" }()
+	if got := BundleVersion(); got != "v9.9.9" {
+		t.Errorf("expected the version set at build time, got %q", got)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdcompat compares two bundles of Gateway API CRDs and classifies
+// every difference by its impact on users.
+package crdcompat
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+// Bundle is the set of CRDs of every release channel of a Gateway API
+// release.
+type Bundle struct {
+	// Version is the bundle version recorded on the CRDs.
+	Version string
+	// CRDs maps each channel to the CRDs in it, by name.
+	CRDs map[string]map[string]*apiext.CustomResourceDefinition
+}
+
+// NewBundle returns an empty Bundle.
+func NewBundle() *Bundle {
+	return &Bundle{CRDs: map[string]map[string]*apiext.CustomResourceDefinition{}}
+}
+
+// Add adds every CRD in the YAML documents of data to the bundle. The
+// channel of each CRD is read from its channel annotation, and documents
+// that are not CRDs are ignored.
+func (b *Bundle) Add(data []byte) error {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(data)))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		crd := &apiext.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(doc, crd); err != nil {
+			return err
+		}
+		if crd.Kind != "CustomResourceDefinition" {
+			continue
+		}
+
+		channel := crd.Annotations[consts.ChannelAnnotation]
+		if channel == "" {
+			return fmt.Errorf("CRD %s has no %s annotation", crd.Name, consts.ChannelAnnotation)
+		}
+		version := crd.Annotations[consts.BundleVersionAnnotation]
+		if b.Version == "" {
+			b.Version = version
+		} else if version != b.Version {
+			return fmt.Errorf("CRD %s has bundle version %q, expected %q", crd.Name, version, b.Version)
+		}
+		if b.CRDs[channel] == nil {
+			b.CRDs[channel] = map[string]*apiext.CustomResourceDefinition{}
+		}
+		b.CRDs[channel][crd.Name] = crd
+	}
+}
+
+// LoadDir returns a Bundle of the CRDs in the YAML files below dir, e.g.
+// config/crd.
+func LoadDir(dir string) (*Bundle, error) {
+	bundle := NewBundle()
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".yaml") {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := bundle.Add(data); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdcompat
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Compare returns a report of every change between the old and new bundles.
+func Compare(oldBundle, newBundle *Bundle) *Report {
+	var changes []Change
+	for _, channel := range unionKeys(oldBundle.CRDs, newBundle.CRDs) {
+		oldCRDs, newCRDs := oldBundle.CRDs[channel], newBundle.CRDs[channel]
+		for _, name := range unionKeys(oldCRDs, newCRDs) {
+			c := &comparer{channel: channel, crd: name}
+			c.compareCRD(oldCRDs[name], newCRDs[name])
+			changes = append(changes, c.changes...)
+		}
+	}
+	return newReport(oldBundle.Version, newBundle.Version, changes)
+}
+
+// comparer accumulates the changes to a single CRD.
+type comparer struct {
+	channel string
+	crd     string
+	version string
+	changes []Change
+}
+
+func (c *comparer) add(path string, changeType ChangeType, classification Classification, format string, args ...interface{}) {
+	c.changes = append(c.changes, Change{
+		Channel:        c.channel,
+		CRD:            c.crd,
+		Version:        c.version,
+		Path:           path,
+		Type:           changeType,
+		Classification: classification,
+		Message:        fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *comparer) compareCRD(oldCRD, newCRD *apiext.CustomResourceDefinition) {
+	switch {
+	case oldCRD == nil:
+		c.add("", CRDAdded, VersionBump, "CRD added to the %s channel", c.channel)
+		return
+	case newCRD == nil:
+		c.add("", CRDRemoved, Breaking, "CRD removed from the %s channel", c.channel)
+		return
+	}
+
+	if oldCRD.Spec.Scope != newCRD.Spec.Scope {
+		c.add("", ScopeChanged, Breaking, "scope changed from %s to %s", oldCRD.Spec.Scope, newCRD.Spec.Scope)
+	}
+	c.compareNames(oldCRD.Spec.Names, newCRD.Spec.Names)
+
+	oldVersions := map[string]apiext.CustomResourceDefinitionVersion{}
+	for _, v := range oldCRD.Spec.Versions {
+		oldVersions[v.Name] = v
+	}
+	newVersions := map[string]apiext.CustomResourceDefinitionVersion{}
+	for _, v := range newCRD.Spec.Versions {
+		newVersions[v.Name] = v
+	}
+
+	for _, name := range unionKeys(oldVersions, newVersions) {
+		c.version = name
+		oldVersion, inOld := oldVersions[name]
+		newVersion, inNew := newVersions[name]
+		switch {
+		case !inOld:
+			c.add("", VersionAdded, VersionBump, "version %s added", name)
+		case !inNew:
+			c.add("", VersionRemoved, Breaking, "version %s removed", name)
+		default:
+			c.compareVersion(&oldVersion, &newVersion)
+		}
+	}
+	c.version = ""
+}
+
+func (c *comparer) compareNames(oldNames, newNames apiext.CustomResourceDefinitionNames) {
+	if oldNames.Kind != newNames.Kind || oldNames.ListKind != newNames.ListKind ||
+		oldNames.Plural != newNames.Plural || oldNames.Singular != newNames.Singular {
+		c.add("", NamesChanged, Breaking, "names changed from %s/%s to %s/%s",
+			oldNames.Kind, oldNames.Plural, newNames.Kind, newNames.Plural)
+	}
+	if removed, added := diffStrings(oldNames.ShortNames, newNames.ShortNames); len(removed) > 0 {
+		c.add("", NamesChanged, Breaking, "short names removed: %s", strings.Join(removed, ", "))
+	} else if len(added) > 0 {
+		c.add("", NamesChanged, VersionBump, "short names added: %s", strings.Join(added, ", "))
+	}
+	if removed, added := diffStrings(oldNames.Categories, newNames.Categories); len(removed) > 0 {
+		c.add("", NamesChanged, Breaking, "categories removed: %s", strings.Join(removed, ", "))
+	} else if len(added) > 0 {
+		c.add("", NamesChanged, VersionBump, "categories added: %s", strings.Join(added, ", "))
+	}
+}
+
+func (c *comparer) compareVersion(oldVersion, newVersion *apiext.CustomResourceDefinitionVersion) {
+	switch {
+	case oldVersion.Served && !newVersion.Served:
+		c.add("", VersionNotServed, Breaking, "version is no longer served")
+	case !oldVersion.Served && newVersion.Served:
+		c.add("", VersionServed, VersionBump, "version is now served")
+	}
+	if oldVersion.Storage != newVersion.Storage {
+		c.add("", StorageVersionChanged, VersionBump, "storage changed from %t to %t", oldVersion.Storage, newVersion.Storage)
+	}
+	if !oldVersion.Deprecated && newVersion.Deprecated {
+		c.add("", VersionDeprecated, VersionBump, "version is now deprecated")
+	}
+
+	oldStatus := oldVersion.Subresources != nil && oldVersion.Subresources.Status != nil
+	newStatus := newVersion.Subresources != nil && newVersion.Subresources.Status != nil
+	switch {
+	case oldStatus && !newStatus:
+		c.add("", SubresourceRemoved, Breaking, "status subresource removed")
+	case !oldStatus && newStatus:
+		c.add("", SubresourceAdded, VersionBump, "status subresource added")
+	}
+
+	if !reflect.DeepEqual(oldVersion.AdditionalPrinterColumns, newVersion.AdditionalPrinterColumns) {
+		c.add("", PrinterColumnsChanged, Compatible, "additional printer columns changed")
+	}
+
+	var oldSchema, newSchema *apiext.JSONSchemaProps
+	if oldVersion.Schema != nil {
+		oldSchema = oldVersion.Schema.OpenAPIV3Schema
+	}
+	if newVersion.Schema != nil {
+		newSchema = newVersion.Schema.OpenAPIV3Schema
+	}
+	if oldSchema != nil && newSchema != nil {
+		c.compareSchema("", oldSchema, newSchema)
+	}
+}
+
+// compareSchema compares the schemas of a field that exists in both
+// versions, recursing into its properties and items.
+func (c *comparer) compareSchema(path string, oldSchema, newSchema *apiext.JSONSchemaProps) {
+	if oldSchema.Type != newSchema.Type {
+		c.add(path, TypeChanged, Breaking, "type changed from %q to %q", oldSchema.Type, newSchema.Type)
+		return
+	}
+
+	switch {
+	case oldSchema.Format == newSchema.Format:
+	case newSchema.Format == "":
+		c.add(path, FormatChanged, VersionBump, "format %q removed", oldSchema.Format)
+	default:
+		c.add(path, FormatChanged, Breaking, "format changed from %q to %q", oldSchema.Format, newSchema.Format)
+	}
+
+	switch {
+	case oldSchema.Pattern == newSchema.Pattern:
+	case newSchema.Pattern == "":
+		c.add(path, PatternChanged, VersionBump, "pattern %q removed", oldSchema.Pattern)
+	default:
+		c.add(path, PatternChanged, Breaking, "pattern changed from %q to %q", oldSchema.Pattern, newSchema.Pattern)
+	}
+
+	c.compareEnum(path, oldSchema.Enum, newSchema.Enum)
+	c.compareBounds(path, oldSchema, newSchema)
+	c.compareDefault(path, oldSchema.Default, newSchema.Default)
+	c.compareValidationRules(path, oldSchema.XValidations, newSchema.XValidations)
+
+	if !reflect.DeepEqual(oldSchema.XListType, newSchema.XListType) || !reflect.DeepEqual(oldSchema.XListMapKeys, newSchema.XListMapKeys) {
+		classification := Breaking
+		// Lists are atomic unless they set another list type, so making that
+		// explicit changes neither validation nor merging.
+		if oldSchema.Type == "array" && effectiveListType(oldSchema) == effectiveListType(newSchema) {
+			classification = Compatible
+		}
+		c.add(path, ListTypeChanged, classification, "list type changed from %s to %s",
+			listType(oldSchema), listType(newSchema))
+	}
+
+	switch {
+	case oldSchema.Nullable && !newSchema.Nullable:
+		c.add(path, NullableChanged, Breaking, "field is no longer nullable")
+	case !oldSchema.Nullable && newSchema.Nullable:
+		c.add(path, NullableChanged, VersionBump, "field is now nullable")
+	}
+
+	if !reflect.DeepEqual(oldSchema.OneOf, newSchema.OneOf) || !reflect.DeepEqual(oldSchema.AnyOf, newSchema.AnyOf) ||
+		!reflect.DeepEqual(oldSchema.AllOf, newSchema.AllOf) || !reflect.DeepEqual(oldSchema.Not, newSchema.Not) {
+		c.add(path, SchemaCombinersChanged, Breaking, "oneOf, anyOf, allOf or not changed")
+	}
+
+	if oldSchema.Description != newSchema.Description {
+		c.add(path, DescriptionChanged, Compatible, "description changed")
+	}
+
+	removedRequired, addedRequired := diffStrings(oldSchema.Required, newSchema.Required)
+	for _, name := range addedRequired {
+		c.add(joinPath(path, name), FieldRequired, Breaking, "field is now required")
+	}
+	for _, name := range removedRequired {
+		c.add(joinPath(path, name), FieldOptional, VersionBump, "field is no longer required")
+	}
+
+	for _, name := range unionKeys(oldSchema.Properties, newSchema.Properties) {
+		oldProp, inOld := oldSchema.Properties[name]
+		newProp, inNew := newSchema.Properties[name]
+		switch {
+		case !inOld:
+			c.add(joinPath(path, name), FieldAdded, VersionBump, "field added")
+		case !inNew:
+			c.add(joinPath(path, name), FieldRemoved, Breaking, "field removed")
+		default:
+			c.compareSchema(joinPath(path, name), &oldProp, &newProp)
+		}
+	}
+
+	if oldSchema.Items != nil && newSchema.Items != nil && oldSchema.Items.Schema != nil && newSchema.Items.Schema != nil {
+		c.compareSchema(path+"[*]", oldSchema.Items.Schema, newSchema.Items.Schema)
+	}
+	if oldSchema.AdditionalProperties != nil && newSchema.AdditionalProperties != nil &&
+		oldSchema.AdditionalProperties.Schema != nil && newSchema.AdditionalProperties.Schema != nil {
+		c.compareSchema(path+"{*}", oldSchema.AdditionalProperties.Schema, newSchema.AdditionalProperties.Schema)
+	}
+}
+
+func (c *comparer) compareEnum(path string, oldEnum, newEnum []apiext.JSON) {
+	if len(oldEnum) == 0 && len(newEnum) == 0 {
+		return
+	}
+	if len(oldEnum) == 0 {
+		c.add(path, EnumValueRemoved, Breaking, "field is now restricted to the values %s", strings.Join(enumValues(newEnum), ", "))
+		return
+	}
+	if len(newEnum) == 0 {
+		c.add(path, EnumValueAdded, VersionBump, "field is no longer restricted to an enum")
+		return
+	}
+	removed, added := diffStrings(enumValues(oldEnum), enumValues(newEnum))
+	for _, value := range removed {
+		c.add(path, EnumValueRemoved, Breaking, "enum value %s removed", value)
+	}
+	for _, value := range added {
+		c.add(path, EnumValueAdded, VersionBump, "enum value %s added", value)
+	}
+}
+
+func (c *comparer) compareBounds(path string, oldSchema, newSchema *apiext.JSONSchemaProps) {
+	compareMinimum(c, path, "minimum", oldSchema.Minimum, newSchema.Minimum)
+	compareMaximum(c, path, "maximum", oldSchema.Maximum, newSchema.Maximum)
+	compareMinimum(c, path, "minLength", oldSchema.MinLength, newSchema.MinLength)
+	compareMaximum(c, path, "maxLength", oldSchema.MaxLength, newSchema.MaxLength)
+	compareMinimum(c, path, "minItems", oldSchema.MinItems, newSchema.MinItems)
+	compareMaximum(c, path, "maxItems", oldSchema.MaxItems, newSchema.MaxItems)
+	compareMinimum(c, path, "minProperties", oldSchema.MinProperties, newSchema.MinProperties)
+	compareMaximum(c, path, "maxProperties", oldSchema.MaxProperties, newSchema.MaxProperties)
+
+	if oldSchema.ExclusiveMinimum != newSchema.ExclusiveMinimum || oldSchema.ExclusiveMaximum != newSchema.ExclusiveMaximum {
+		c.add(path, BoundsTightened, Breaking, "exclusive bounds changed")
+	}
+}
+
+type number interface {
+	~int64 | ~float64
+}
+
+func compareMinimum[T number](c *comparer, path, name string, oldMin, newMin *T) {
+	switch {
+	case oldMin == nil && newMin == nil:
+	case oldMin == nil:
+		c.add(path, BoundsTightened, Breaking, "%s %v added", name, *newMin)
+	case newMin == nil:
+		c.add(path, BoundsRelaxed, VersionBump, "%s %v removed", name, *oldMin)
+	case *newMin > *oldMin:
+		c.add(path, BoundsTightened, Breaking, "%s raised from %v to %v", name, *oldMin, *newMin)
+	case *newMin < *oldMin:
+		c.add(path, BoundsRelaxed, VersionBump, "%s lowered from %v to %v", name, *oldMin, *newMin)
+	}
+}
+
+func compareMaximum[T number](c *comparer, path, name string, oldMax, newMax *T) {
+	switch {
+	case oldMax == nil && newMax == nil:
+	case oldMax == nil:
+		c.add(path, BoundsTightened, Breaking, "%s %v added", name, *newMax)
+	case newMax == nil:
+		c.add(path, BoundsRelaxed, VersionBump, "%s %v removed", name, *oldMax)
+	case *newMax < *oldMax:
+		c.add(path, BoundsTightened, Breaking, "%s lowered from %v to %v", name, *oldMax, *newMax)
+	case *newMax > *oldMax:
+		c.add(path, BoundsRelaxed, VersionBump, "%s raised from %v to %v", name, *oldMax, *newMax)
+	}
+}
+
+func (c *comparer) compareDefault(path string, oldDefault, newDefault *apiext.JSON) {
+	switch {
+	case oldDefault == nil && newDefault == nil:
+	case oldDefault == nil:
+		c.add(path, DefaultChanged, VersionBump, "default %s added", newDefault.Raw)
+	case newDefault == nil:
+		c.add(path, DefaultChanged, Breaking, "default %s removed", oldDefault.Raw)
+	case string(oldDefault.Raw) != string(newDefault.Raw):
+		c.add(path, DefaultChanged, Breaking, "default changed from %s to %s", oldDefault.Raw, newDefault.Raw)
+	}
+}
+
+// compareValidationRules compares CEL rules by their expression. A new rule
+// is breaking unless it is a transition rule, i.e. it references oldSelf and
+// so only applies to updates where it can ratchet on the previous value.
+func (c *comparer) compareValidationRules(path string, oldRules, newRules apiext.ValidationRules) {
+	oldByRule := map[string]apiext.ValidationRule{}
+	for _, rule := range oldRules {
+		oldByRule[rule.Rule] = rule
+	}
+	newByRule := map[string]apiext.ValidationRule{}
+	for _, rule := range newRules {
+		newByRule[rule.Rule] = rule
+	}
+
+	for _, expression := range unionKeys(oldByRule, newByRule) {
+		oldRule, inOld := oldByRule[expression]
+		newRule, inNew := newByRule[expression]
+		switch {
+		case !inOld && strings.Contains(expression, "oldSelf"):
+			c.add(path, ValidationRuleAdded, VersionBump, "transition rule added: %s", expression)
+		case !inOld:
+			c.add(path, ValidationRuleAdded, Breaking, "validation rule added without ratcheting: %s", expression)
+		case !inNew:
+			c.add(path, ValidationRuleRemoved, VersionBump, "validation rule removed: %s", expression)
+		case !reflect.DeepEqual(oldRule, newRule):
+			c.add(path, ValidationRuleChanged, Compatible, "message of validation rule changed: %s", expression)
+		}
+	}
+}
+
+// effectiveListType returns the list type of schema, which defaults to
+// atomic.
+func effectiveListType(schema *apiext.JSONSchemaProps) string {
+	if schema.XListType == nil {
+		return "atomic"
+	}
+	return listType(schema)
+}
+
+func listType(schema *apiext.JSONSchemaProps) string {
+	if schema.XListType == nil {
+		return "unset"
+	}
+	if len(schema.XListMapKeys) > 0 {
+		return fmt.Sprintf("%s(%s)", *schema.XListType, strings.Join(schema.XListMapKeys, ","))
+	}
+	return *schema.XListType
+}
+
+func enumValues(enum []apiext.JSON) []string {
+	values := make([]string, 0, len(enum))
+	for _, value := range enum {
+		values = append(values, string(value.Raw))
+	}
+	return values
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// diffStrings returns the values only in oldValues and only in newValues,
+// sorted.
+func diffStrings(oldValues, newValues []string) (removed, added []string) {
+	oldSet := map[string]bool{}
+	for _, v := range oldValues {
+		oldSet[v] = true
+	}
+	newSet := map[string]bool{}
+	for _, v := range newValues {
+		newSet[v] = true
+		if !oldSet[v] {
+			added = append(added, v)
+		}
+	}
+	for _, v := range oldValues {
+		if !newSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+	return removed, added
+}
+
+// unionKeys returns the sorted union of the keys of a and b.
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
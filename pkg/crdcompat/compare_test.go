@@ -0,0 +1,447 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdcompat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
+
+func ptrTo[T any](a T) *T {
+	return &a
+}
+
+const testCRDName = "widgets.gateway.networking.k8s.io"
+
+// testCRD returns a small CRD whose spec has a required enum field, a
+// string field with a pattern and a list of objects.
+func testCRD() *apiext.CustomResourceDefinition {
+	return &apiext.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testCRDName,
+			Annotations: map[string]string{
+				consts.BundleVersionAnnotation: "v1.0.0",
+				consts.ChannelAnnotation:       "standard",
+			},
+		},
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Group: "gateway.networking.k8s.io",
+			Names: apiext.CustomResourceDefinitionNames{Kind: "Widget", ListKind: "WidgetList", Plural: "widgets", Singular: "widget"},
+			Scope: apiext.NamespaceScoped,
+			Versions: []apiext.CustomResourceDefinitionVersion{{
+				Name:         "v1beta1",
+				Served:       true,
+				Storage:      true,
+				Subresources: &apiext.CustomResourceSubresources{Status: &apiext.CustomResourceSubresourceStatus{}},
+				Schema: &apiext.CustomResourceValidation{OpenAPIV3Schema: &apiext.JSONSchemaProps{
+					Type: "object",
+					Properties: map[string]apiext.JSONSchemaProps{
+						"spec": {
+							Type:     "object",
+							Required: []string{"mode"},
+							Properties: map[string]apiext.JSONSchemaProps{
+								"mode": {
+									Type: "string",
+									Enum: []apiext.JSON{{Raw: []byte(`"A"`)}, {Raw: []byte(`"B"`)}},
+								},
+								"name": {
+									Type:      "string",
+									Pattern:   "^[a-z]+$",
+									MaxLength: ptrTo(int64(63)),
+								},
+								"items": {
+									Type:     "array",
+									MaxItems: ptrTo(int64(16)),
+									Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+										Type: "object",
+										Properties: map[string]apiext.JSONSchemaProps{
+											"port": {Type: "integer", Minimum: ptrTo(1.0), Maximum: ptrTo(65535.0)},
+										},
+									}},
+								},
+							},
+							XValidations: apiext.ValidationRules{{Rule: "self.mode == 'A' || has(self.name)", Message: "name is required for mode B"}},
+						},
+					},
+				}},
+			}},
+		},
+	}
+}
+
+func bundleOf(crds ...*apiext.CustomResourceDefinition) *Bundle {
+	bundle := NewBundle()
+	for _, crd := range crds {
+		channel := crd.Annotations[consts.ChannelAnnotation]
+		if bundle.CRDs[channel] == nil {
+			bundle.CRDs[channel] = map[string]*apiext.CustomResourceDefinition{}
+		}
+		bundle.CRDs[channel][crd.Name] = crd
+		bundle.Version = crd.Annotations[consts.BundleVersionAnnotation]
+	}
+	return bundle
+}
+
+func specSchema(crd *apiext.CustomResourceDefinition) *apiext.JSONSchemaProps {
+	spec := crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+	return &spec
+}
+
+func setSpecSchema(crd *apiext.CustomResourceDefinition, spec *apiext.JSONSchemaProps) {
+	crd.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"] = *spec
+}
+
+func updateSpecProperty(crd *apiext.CustomResourceDefinition, name string, update func(*apiext.JSONSchemaProps)) {
+	spec := specSchema(crd)
+	prop := spec.Properties[name]
+	update(&prop)
+	spec.Properties[name] = prop
+	setSpecSchema(crd, spec)
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutate         func(crd *apiext.CustomResourceDefinition)
+		path           string
+		changeType     ChangeType
+		classification Classification
+	}{{
+		name: "description only",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "name", func(p *apiext.JSONSchemaProps) { p.Description = "The name." })
+		},
+		path:           "spec.name",
+		changeType:     DescriptionChanged,
+		classification: Compatible,
+	}, {
+		name: "optional field added",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.Properties["color"] = apiext.JSONSchemaProps{Type: "string"}
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec.color",
+		changeType:     FieldAdded,
+		classification: VersionBump,
+	}, {
+		name: "field removed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			delete(spec.Properties, "name")
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec.name",
+		changeType:     FieldRemoved,
+		classification: Breaking,
+	}, {
+		name: "field made required",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.Required = append(spec.Required, "name")
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec.name",
+		changeType:     FieldRequired,
+		classification: Breaking,
+	}, {
+		name: "field made optional",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.Required = nil
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec.mode",
+		changeType:     FieldOptional,
+		classification: VersionBump,
+	}, {
+		name: "enum value removed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "mode", func(p *apiext.JSONSchemaProps) { p.Enum = p.Enum[:1] })
+		},
+		path:           "spec.mode",
+		changeType:     EnumValueRemoved,
+		classification: Breaking,
+	}, {
+		name: "enum value added",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "mode", func(p *apiext.JSONSchemaProps) {
+				p.Enum = append(p.Enum, apiext.JSON{Raw: []byte(`"C"`)})
+			})
+		},
+		path:           "spec.mode",
+		changeType:     EnumValueAdded,
+		classification: VersionBump,
+	}, {
+		name: "pattern tightened",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "name", func(p *apiext.JSONSchemaProps) { p.Pattern = "^[a-z]{1,8}$" })
+		},
+		path:           "spec.name",
+		changeType:     PatternChanged,
+		classification: Breaking,
+	}, {
+		name: "pattern removed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "name", func(p *apiext.JSONSchemaProps) { p.Pattern = "" })
+		},
+		path:           "spec.name",
+		changeType:     PatternChanged,
+		classification: VersionBump,
+	}, {
+		name: "maxLength lowered",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "name", func(p *apiext.JSONSchemaProps) { p.MaxLength = ptrTo(int64(32)) })
+		},
+		path:           "spec.name",
+		changeType:     BoundsTightened,
+		classification: Breaking,
+	}, {
+		name: "maxItems raised",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "items", func(p *apiext.JSONSchemaProps) { p.MaxItems = ptrTo(int64(32)) })
+		},
+		path:           "spec.items",
+		changeType:     BoundsRelaxed,
+		classification: VersionBump,
+	}, {
+		name: "minimum raised on array items",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "items", func(p *apiext.JSONSchemaProps) {
+				items := p.Items.Schema.DeepCopy()
+				port := items.Properties["port"]
+				port.Minimum = ptrTo(1024.0)
+				items.Properties["port"] = port
+				p.Items = &apiext.JSONSchemaPropsOrArray{Schema: items}
+			})
+		},
+		path:           "spec.items[*].port",
+		changeType:     BoundsTightened,
+		classification: Breaking,
+	}, {
+		name: "type changed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "name", func(p *apiext.JSONSchemaProps) {
+				*p = apiext.JSONSchemaProps{Type: "integer"}
+			})
+		},
+		path:           "spec.name",
+		changeType:     TypeChanged,
+		classification: Breaking,
+	}, {
+		name: "CEL rule added without ratcheting",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.XValidations = append(spec.XValidations, apiext.ValidationRule{Rule: "size(self.items) > 0"})
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec",
+		changeType:     ValidationRuleAdded,
+		classification: Breaking,
+	}, {
+		name: "CEL transition rule added",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.XValidations = append(spec.XValidations, apiext.ValidationRule{Rule: "self.mode == oldSelf.mode"})
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec",
+		changeType:     ValidationRuleAdded,
+		classification: VersionBump,
+	}, {
+		name: "CEL rule message changed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.XValidations = apiext.ValidationRules{{Rule: spec.XValidations[0].Rule, Message: "name must be set"}}
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec",
+		changeType:     ValidationRuleChanged,
+		classification: Compatible,
+	}, {
+		name: "CEL rule removed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			spec := specSchema(crd)
+			spec.XValidations = nil
+			setSpecSchema(crd, spec)
+		},
+		path:           "spec",
+		changeType:     ValidationRuleRemoved,
+		classification: VersionBump,
+	}, {
+		name: "list type changed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "items", func(p *apiext.JSONSchemaProps) {
+				p.XListType = ptrTo("map")
+				p.XListMapKeys = []string{"port"}
+			})
+		},
+		path:           "spec.items",
+		changeType:     ListTypeChanged,
+		classification: Breaking,
+	}, {
+		name: "list type set to its default",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "items", func(p *apiext.JSONSchemaProps) { p.XListType = ptrTo("atomic") })
+		},
+		path:           "spec.items",
+		changeType:     ListTypeChanged,
+		classification: Compatible,
+	}, {
+		name: "default changed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			updateSpecProperty(crd, "mode", func(p *apiext.JSONSchemaProps) { p.Default = &apiext.JSON{Raw: []byte(`"A"`)} })
+		},
+		path:           "spec.mode",
+		changeType:     DefaultChanged,
+		classification: VersionBump,
+	}, {
+		name: "version no longer served",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			crd.Spec.Versions[0].Served = false
+		},
+		changeType:     VersionNotServed,
+		classification: Breaking,
+	}, {
+		name: "version added",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			v1 := *crd.Spec.Versions[0].DeepCopy()
+			v1.Name = "v1"
+			v1.Storage = false
+			crd.Spec.Versions = append(crd.Spec.Versions, v1)
+		},
+		changeType:     VersionAdded,
+		classification: VersionBump,
+	}, {
+		name: "status subresource removed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			crd.Spec.Versions[0].Subresources = nil
+		},
+		changeType:     SubresourceRemoved,
+		classification: Breaking,
+	}, {
+		name: "scope changed",
+		mutate: func(crd *apiext.CustomResourceDefinition) {
+			crd.Spec.Scope = apiext.ClusterScoped
+		},
+		changeType:     ScopeChanged,
+		classification: Breaking,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldCRD := testCRD()
+			newCRD := testCRD()
+			newCRD.Annotations[consts.BundleVersionAnnotation] = "v1.1.0"
+			tc.mutate(newCRD)
+
+			report := Compare(bundleOf(oldCRD), bundleOf(newCRD))
+			require.Len(t, report.Changes, 1, "changes: %+v", report.Changes)
+			change := report.Changes[0]
+			assert.Equal(t, "standard", change.Channel)
+			assert.Equal(t, testCRDName, change.CRD)
+			assert.Equal(t, tc.path, change.Path)
+			assert.Equal(t, tc.changeType, change.Type)
+			assert.Equal(t, tc.classification, change.Classification)
+			assert.Equal(t, tc.classification, report.Result)
+			assert.Equal(t, "v1.0.0", report.OldBundleVersion)
+			assert.Equal(t, "v1.1.0", report.NewBundleVersion)
+		})
+	}
+}
+
+func TestCompareChannels(t *testing.T) {
+	standard := testCRD()
+	experimental := testCRD()
+	experimental.Annotations[consts.ChannelAnnotation] = "experimental"
+	spec := specSchema(experimental)
+	spec.Properties["color"] = apiext.JSONSchemaProps{Type: "string"}
+	setSpecSchema(experimental, spec)
+
+	t.Run("field dropped from standard", func(t *testing.T) {
+		oldStandard := testCRD()
+		spec := specSchema(oldStandard)
+		spec.Properties["color"] = apiext.JSONSchemaProps{Type: "string"}
+		setSpecSchema(oldStandard, spec)
+
+		report := Compare(bundleOf(oldStandard, experimental), bundleOf(standard, experimental))
+		require.Len(t, report.Changes, 1)
+		assert.Equal(t, "standard", report.Changes[0].Channel)
+		assert.Equal(t, FieldRemoved, report.Changes[0].Type)
+		assert.Equal(t, Breaking, report.Result)
+	})
+
+	t.Run("CRD dropped from standard", func(t *testing.T) {
+		report := Compare(bundleOf(standard, experimental), bundleOf(experimental))
+		require.Len(t, report.Changes, 1)
+		assert.Equal(t, CRDRemoved, report.Changes[0].Type)
+		assert.Equal(t, Breaking, report.Result)
+	})
+
+	t.Run("CRD added to standard", func(t *testing.T) {
+		report := Compare(bundleOf(experimental), bundleOf(standard, experimental))
+		require.Len(t, report.Changes, 1)
+		assert.Equal(t, CRDAdded, report.Changes[0].Type)
+		assert.Equal(t, Summary{VersionBump: 1}, report.Summary)
+	})
+}
+
+func TestBundleAdd(t *testing.T) {
+	standard, err := yaml.Marshal(testCRD())
+	require.NoError(t, err)
+	experimentalCRD := testCRD()
+	experimentalCRD.Annotations[consts.ChannelAnnotation] = "experimental"
+	experimental, err := yaml.Marshal(experimentalCRD)
+	require.NoError(t, err)
+
+	bundle := NewBundle()
+	data := bytes.Join([][]byte{standard, []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ignored\n"), experimental}, []byte("---\n"))
+	require.NoError(t, bundle.Add(data))
+	assert.Equal(t, "v1.0.0", bundle.Version)
+	assert.Contains(t, bundle.CRDs["standard"], testCRDName)
+	assert.Contains(t, bundle.CRDs["experimental"], testCRDName)
+
+	other := testCRD()
+	other.Annotations[consts.BundleVersionAnnotation] = "v2.0.0"
+	data, err = yaml.Marshal(other)
+	require.NoError(t, err)
+	assert.Error(t, bundle.Add(data), "expected an error for mixed bundle versions")
+}
+
+func TestCompareGeneratedCRDs(t *testing.T) {
+	bundle, err := LoadDir("../../config/crd")
+	require.NoError(t, err)
+	require.NotEmpty(t, bundle.CRDs["standard"])
+	require.NotEmpty(t, bundle.CRDs["experimental"])
+
+	report := Compare(bundle, bundle)
+	assert.Empty(t, report.Changes)
+	assert.Equal(t, Compatible, report.Result)
+
+	var out bytes.Buffer
+	require.NoError(t, report.WriteText(&out, VersionBump))
+	assert.Contains(t, out.String(), "Compatible")
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdcompat
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Classification describes the impact of a change on users of a CRD.
+type Classification string
+
+const (
+	// Compatible changes do not affect how objects are validated or stored,
+	// e.g. documentation updates.
+	Compatible Classification = "Compatible"
+
+	// VersionBump changes are backwards compatible additions, e.g. a new
+	// optional field or enum value, that must ship in a new bundle version.
+	VersionBump Classification = "VersionBump"
+
+	// Breaking changes may cause existing objects or clients to stop
+	// working, e.g. removing a field or tightening validation.
+	Breaking Classification = "Breaking"
+)
+
+var severity = map[Classification]int{
+	Compatible:  0,
+	VersionBump: 1,
+	Breaking:    2,
+}
+
+// AtLeast returns true if c is at least as severe as other.
+func (c Classification) AtLeast(other Classification) bool {
+	return severity[c] >= severity[other]
+}
+
+// ChangeType identifies what changed between two versions of a CRD.
+type ChangeType string
+
+const (
+	CRDAdded               ChangeType = "CRDAdded"
+	CRDRemoved             ChangeType = "CRDRemoved"
+	ScopeChanged           ChangeType = "ScopeChanged"
+	NamesChanged           ChangeType = "NamesChanged"
+	VersionAdded           ChangeType = "VersionAdded"
+	VersionRemoved         ChangeType = "VersionRemoved"
+	VersionServed          ChangeType = "VersionServed"
+	VersionNotServed       ChangeType = "VersionNotServed"
+	VersionDeprecated      ChangeType = "VersionDeprecated"
+	StorageVersionChanged  ChangeType = "StorageVersionChanged"
+	SubresourceAdded       ChangeType = "SubresourceAdded"
+	SubresourceRemoved     ChangeType = "SubresourceRemoved"
+	PrinterColumnsChanged  ChangeType = "PrinterColumnsChanged"
+	FieldAdded             ChangeType = "FieldAdded"
+	FieldRemoved           ChangeType = "FieldRemoved"
+	FieldRequired          ChangeType = "FieldRequired"
+	FieldOptional          ChangeType = "FieldOptional"
+	TypeChanged            ChangeType = "TypeChanged"
+	FormatChanged          ChangeType = "FormatChanged"
+	EnumValueAdded         ChangeType = "EnumValueAdded"
+	EnumValueRemoved       ChangeType = "EnumValueRemoved"
+	PatternChanged         ChangeType = "PatternChanged"
+	BoundsTightened        ChangeType = "BoundsTightened"
+	BoundsRelaxed          ChangeType = "BoundsRelaxed"
+	DefaultChanged         ChangeType = "DefaultChanged"
+	ListTypeChanged        ChangeType = "ListTypeChanged"
+	NullableChanged        ChangeType = "NullableChanged"
+	SchemaCombinersChanged ChangeType = "SchemaCombinersChanged"
+	ValidationRuleAdded    ChangeType = "ValidationRuleAdded"
+	ValidationRuleRemoved  ChangeType = "ValidationRuleRemoved"
+	ValidationRuleChanged  ChangeType = "ValidationRuleChanged"
+	DescriptionChanged     ChangeType = "DescriptionChanged"
+)
+
+// Change is a single difference between two versions of a CRD.
+type Change struct {
+	// Channel is the release channel of the CRD, e.g. "standard".
+	Channel string `json:"channel"`
+	// CRD is the name of the CRD, e.g. "httproutes.gateway.networking.k8s.io".
+	CRD string `json:"crd"`
+	// Version is the API version within the CRD, if the change is specific
+	// to one.
+	Version string `json:"version,omitempty"`
+	// Path is the path of the schema field, if the change is specific to
+	// one. Array items are represented as "[*]".
+	Path           string         `json:"path,omitempty"`
+	Type           ChangeType     `json:"type"`
+	Classification Classification `json:"classification"`
+	Message        string         `json:"message"`
+}
+
+// Summary counts the changes of each classification.
+type Summary struct {
+	Compatible  int `json:"compatible"`
+	VersionBump int `json:"versionBump"`
+	Breaking    int `json:"breaking"`
+}
+
+// Report is the result of comparing two bundles.
+type Report struct {
+	OldBundleVersion string `json:"oldBundleVersion,omitempty"`
+	NewBundleVersion string `json:"newBundleVersion,omitempty"`
+	// Result is the most severe classification of all changes.
+	Result  Classification `json:"result"`
+	Summary Summary        `json:"summary"`
+	Changes []Change       `json:"changes"`
+}
+
+func newReport(oldVersion, newVersion string, changes []Change) *Report {
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Channel != b.Channel {
+			return a.Channel > b.Channel // "standard" before "experimental"
+		}
+		if a.CRD != b.CRD {
+			return a.CRD < b.CRD
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.Path < b.Path
+	})
+
+	report := &Report{
+		OldBundleVersion: oldVersion,
+		NewBundleVersion: newVersion,
+		Result:           Compatible,
+		Changes:          changes,
+	}
+	if report.Changes == nil {
+		report.Changes = []Change{}
+	}
+	for _, c := range changes {
+		switch c.Classification {
+		case Compatible:
+			report.Summary.Compatible++
+		case VersionBump:
+			report.Summary.VersionBump++
+		case Breaking:
+			report.Summary.Breaking++
+		}
+		if c.Classification.AtLeast(report.Result) {
+			report.Result = c.Classification
+		}
+	}
+	return report
+}
+
+// WriteText writes a human readable version of the report to w, omitting
+// changes less severe than minimum.
+func (r *Report) WriteText(w io.Writer, minimum Classification) error {
+	if _, err := fmt.Fprintf(w, "Comparing bundle %s to %s: %s (%d breaking, %d version bump, %d compatible)\n",
+		orUnknown(r.OldBundleVersion), orUnknown(r.NewBundleVersion), r.Result,
+		r.Summary.Breaking, r.Summary.VersionBump, r.Summary.Compatible); err != nil {
+		return err
+	}
+	for _, c := range r.Changes {
+		if !c.Classification.AtLeast(minimum) {
+			continue
+		}
+		location := c.Channel + "/" + c.CRD
+		if c.Version != "" {
+			location += "/" + c.Version
+		}
+		if c.Path != "" {
+			location += ": " + c.Path
+		}
+		if _, err := fmt.Fprintf(w, "  [%s] %s %s: %s\n", c.Classification, location, c.Type, c.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func orUnknown(version string) string {
+	if version == "" {
+		return "(unknown)"
+	}
+	return version
+}
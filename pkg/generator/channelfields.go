@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"sort"
+	"text/template"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// channelFieldsFile is the file of the validation package listing the fields
+// that are only part of the experimental channel.
+const channelFieldsFile = "apis/v1beta1/validation/zz_generated.channels.go"
+
+var channelFieldsTemplate = template.Must(template.New("channels").Parse(`{{ .Header }}
+// Code generated by pkg/generator. DO NOT EDIT.
+
+package validation
+
+// experimentalFields lists the fields of every kind that are only part of the
+// experimental channel, and are dropped by the API server when they are set on
+// a standard channel install. List items are denoted by [*].
+var experimentalFields = map[string][]string{
+{{- range $kind, $paths := .Fields }}
+	"{{ $kind }}": {
+	{{- range $paths }}
+		"{{ . }}",
+	{{- end }}
+	},
+{{- end }}
+}
+`))
+
+// experimentalOnlyFields returns the paths of the fields of experimental that
+// are not in standard, without descending into them.
+func experimentalOnlyFields(standard, experimental *apiext.JSONSchemaProps, path string) []string {
+	var paths []string
+	for _, name := range sortedPropertyNames(experimental.Properties) {
+		prop := experimental.Properties[name]
+		propPath := name
+		if path != "" {
+			propPath = path + "." + name
+		}
+		standardProp, ok := standard.Properties[name]
+		if !ok {
+			paths = append(paths, propPath)
+			continue
+		}
+		paths = append(paths, experimentalOnlyFields(&standardProp, &prop, propPath)...)
+	}
+	if experimental.Items != nil && experimental.Items.Schema != nil &&
+		standard.Items != nil && standard.Items.Schema != nil {
+		paths = append(paths, experimentalOnlyFields(standard.Items.Schema, experimental.Items.Schema, path+"[*]")...)
+	}
+	return paths
+}
+
+// writeChannelFields writes the experimental-only fields of the v1beta1
+// versions of the standard and experimental CRDs, by kind, to the v1beta1
+// validation package.
+func writeChannelFields(standard, experimental map[string]*apiext.CustomResourceDefinition) error {
+	fields := map[string][]string{}
+	for kind, standardCRD := range standard {
+		experimentalCRD, ok := experimental[kind]
+		if !ok {
+			return fmt.Errorf("%s is part of the standard channel only", kind)
+		}
+		standardSchema, experimentalSchema := versionSchema(standardCRD, "v1beta1"), versionSchema(experimentalCRD, "v1beta1")
+		if standardSchema == nil || experimentalSchema == nil {
+			return fmt.Errorf("%s has no v1beta1 schema", kind)
+		}
+		if paths := experimentalOnlyFields(standardSchema, experimentalSchema, ""); len(paths) > 0 {
+			fields[kind] = paths
+		}
+	}
+
+	header, err := os.ReadFile("hack/boilerplate/boilerplate.generatego.txt")
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := channelFieldsTemplate.Execute(&buf, map[string]interface{}{
+		"Header": string(bytes.TrimSpace(header)),
+		"Fields": fields,
+	}); err != nil {
+		return err
+	}
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(channelFieldsFile, out, 0o600)
+}
+
+func versionSchema(crd *apiext.CustomResourceDefinition, name string) *apiext.JSONSchemaProps {
+	for _, version := range crd.Spec.Versions {
+		if version.Name == name && version.Schema != nil {
+			return version.Schema.OpenAPIV3Schema
+		}
+	}
+	return nil
+}
+
+func sortedPropertyNames(props map[string]apiext.JSONSchemaProps) []string {
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestExperimentalOnlyFields(t *testing.T) {
+	object := func(props map[string]apiext.JSONSchemaProps) apiext.JSONSchemaProps {
+		return apiext.JSONSchemaProps{Type: "object", Properties: props}
+	}
+	list := func(item apiext.JSONSchemaProps) apiext.JSONSchemaProps {
+		return apiext.JSONSchemaProps{Type: "array", Items: &apiext.JSONSchemaPropsOrArray{Schema: &item}}
+	}
+
+	standard := object(map[string]apiext.JSONSchemaProps{
+		"spec": object(map[string]apiext.JSONSchemaProps{
+			"parentRefs": list(object(map[string]apiext.JSONSchemaProps{
+				"name": {Type: "string"},
+			})),
+		}),
+	})
+	experimental := object(map[string]apiext.JSONSchemaProps{
+		"spec": object(map[string]apiext.JSONSchemaProps{
+			"infrastructure": object(map[string]apiext.JSONSchemaProps{
+				"labels": {Type: "object"},
+			}),
+			"parentRefs": list(object(map[string]apiext.JSONSchemaProps{
+				"name": {Type: "string"},
+				"port": {Type: "integer"},
+			})),
+		}),
+	})
+
+	got := experimentalOnlyFields(&standard, &experimental, "")
+	assert.Equal(t, []string{"spec.infrastructure", "spec.parentRefs[*].port"}, got)
+	assert.Empty(t, experimentalOnlyFields(&standard, &standard, ""))
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// conversionWebhookPath is the path of the conversion handler of the
+// admission server.
+const conversionWebhookPath = "/convert"
+
+// setConversionWebhook makes the API server convert the objects of crd
+// between its versions with the webhook served by service, a namespace/name
+// reference. CRDs with a single version need no conversion and are left
+// unchanged.
+func setConversionWebhook(crd *apiext.CustomResourceDefinition, service string) error {
+	if len(crd.Spec.Versions) < 2 {
+		return nil
+	}
+	namespace, name, ok := strings.Cut(service, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("invalid conversion webhook service %q, must be namespace/name", service)
+	}
+
+	path := conversionWebhookPath
+	port := int32(443)
+	crd.Spec.Conversion = &apiext.CustomResourceConversion{
+		Strategy: apiext.WebhookConverter,
+		Webhook: &apiext.WebhookConversion{
+			ClientConfig: &apiext.WebhookClientConfig{
+				Service: &apiext.ServiceReference{
+					Namespace: namespace,
+					Name:      name,
+					Path:      &path,
+					Port:      &port,
+				},
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+	return nil
+}
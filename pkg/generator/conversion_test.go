@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestSetConversionWebhook(t *testing.T) {
+	crdWithVersions := func(names ...string) *apiext.CustomResourceDefinition {
+		crd := &apiext.CustomResourceDefinition{}
+		for _, name := range names {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiext.CustomResourceDefinitionVersion{Name: name})
+		}
+		return crd
+	}
+
+	crd := crdWithVersions("v1alpha2", "v1beta1")
+	require.NoError(t, setConversionWebhook(crd, "gateway-system/gateway-api-admission-server"))
+	require.NotNil(t, crd.Spec.Conversion)
+	assert.Equal(t, apiext.WebhookConverter, crd.Spec.Conversion.Strategy)
+	service := crd.Spec.Conversion.Webhook.ClientConfig.Service
+	assert.Equal(t, "gateway-system", service.Namespace)
+	assert.Equal(t, "gateway-api-admission-server", service.Name)
+	assert.Equal(t, "/convert", *service.Path)
+	assert.Equal(t, []string{"v1"}, crd.Spec.Conversion.Webhook.ConversionReviewVersions)
+
+	crd = crdWithVersions("v1alpha2")
+	require.NoError(t, setConversionWebhook(crd, "gateway-system/gateway-api-admission-server"))
+	assert.Nil(t, crd.Spec.Conversion)
+
+	for _, service := range []string{"gateway-api-admission-server", "/name", "namespace/"} {
+		assert.Error(t, setConversionWebhook(crdWithVersions("v1alpha2", "v1beta1"), service), service)
+	}
+}
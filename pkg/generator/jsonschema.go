@@ -0,0 +1,228 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchemaIndexEntry maps an apiVersion and kind to the path of its JSON
+// Schema, relative to the index.
+type jsonSchemaIndexEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Schema     string `json:"schema"`
+}
+
+// jsonSchemaIndex is the index of the JSON Schemas of a channel.
+type jsonSchemaIndex struct {
+	Channel string                 `json:"channel"`
+	Schemas []jsonSchemaIndexEntry `json:"schemas"`
+}
+
+// writeJSONSchemas writes a standalone JSON Schema for every served version of
+// crd to dir, named like kubeconform expects, e.g. httproute_v1beta1.json, and
+// returns their index entries.
+func writeJSONSchemas(dir string, crd *apiext.CustomResourceDefinition) ([]jsonSchemaIndexEntry, error) {
+	var entries []jsonSchemaIndexEntry
+	for _, version := range crd.Spec.Versions {
+		if !version.Served || version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			continue
+		}
+		apiVersion := crd.Spec.Group + "/" + version.Name
+		schema, err := toJSONSchema(version.Schema.OpenAPIV3Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", apiVersion, crd.Spec.Names.Kind, err)
+		}
+
+		// Pin apiVersion and kind so that editors can pick the schema of a
+		// document from its contents.
+		schema["$schema"] = jsonSchemaDraft
+		props, _ := schema["properties"].(map[string]interface{})
+		if props == nil {
+			props = map[string]interface{}{}
+			schema["properties"] = props
+		}
+		for name, value := range map[string]string{"apiVersion": apiVersion, "kind": crd.Spec.Names.Kind} {
+			prop, _ := props[name].(map[string]interface{})
+			if prop == nil {
+				prop = map[string]interface{}{"type": "string"}
+				props[name] = prop
+			}
+			prop["enum"] = []string{value}
+		}
+
+		out, err := json.MarshalIndent(schema, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		name := fmt.Sprintf("%s_%s.json", strings.ToLower(crd.Spec.Names.Kind), version.Name)
+		if err := os.WriteFile(filepath.Join(dir, name), append(out, '\n'), 0o600); err != nil {
+			return nil, err
+		}
+		entries = append(entries, jsonSchemaIndexEntry{APIVersion: apiVersion, Kind: crd.Spec.Names.Kind, Schema: name})
+	}
+	return entries, nil
+}
+
+// writeJSONSchemaIndex writes the index of the JSON Schemas of channel to
+// dir/index.json.
+func writeJSONSchemaIndex(dir, channel string, entries []jsonSchemaIndexEntry) error {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Kind != entries[j].Kind {
+			return entries[i].Kind < entries[j].Kind
+		}
+		return entries[i].APIVersion < entries[j].APIVersion
+	})
+	out, err := json.MarshalIndent(jsonSchemaIndex{Channel: channel, Schemas: entries}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, "index.json"), append(out, '\n'), 0o600)
+}
+
+// toJSONSchema returns the JSON Schema equivalent of an OpenAPI v3 schema.
+func toJSONSchema(props *apiext.JSONSchemaProps) (map[string]interface{}, error) {
+	raw, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+	schema := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, err
+	}
+	translateSchema(schema)
+	return schema, nil
+}
+
+// translateSchema translates the OpenAPI keywords and Kubernetes extensions of
+// schema and its subschemas to JSON Schema where an equivalent exists. Other
+// extensions, e.g. x-kubernetes-validations, are kept as annotations, which
+// JSON Schema validators ignore.
+func translateSchema(schema map[string]interface{}) {
+	if nullable, _ := schema["nullable"].(bool); nullable {
+		if t, ok := schema["type"].(string); ok {
+			schema["type"] = []string{t, "null"}
+		}
+	}
+	delete(schema, "nullable")
+
+	if intOrString, _ := schema["x-kubernetes-int-or-string"].(bool); intOrString {
+		if _, ok := schema["anyOf"]; !ok {
+			schema["anyOf"] = []interface{}{
+				map[string]interface{}{"type": "integer"},
+				map[string]interface{}{"type": "string"},
+			}
+		}
+	}
+
+	if listType, _ := schema["x-kubernetes-list-type"].(string); listType == "set" {
+		schema["uniqueItems"] = true
+	}
+
+	// The API server prunes unknown fields of objects with properties, so
+	// they are rejected unless x-kubernetes-preserve-unknown-fields is set.
+	// Objects without properties, e.g. metadata, are left open.
+	_, hasProps := schema["properties"]
+	_, hasAdditional := schema["additionalProperties"]
+	preserve, _ := schema["x-kubernetes-preserve-unknown-fields"].(bool)
+	if schema["type"] == "object" && hasProps && !hasAdditional && !preserve {
+		schema["additionalProperties"] = false
+	}
+
+	for _, key := range []string{"anyOf", "oneOf"} {
+		if branches, ok := schema[key].([]interface{}); ok {
+			requireDefaultedProperties(schema, branches)
+		}
+	}
+
+	if props, ok := schema["properties"].(map[string]interface{}); ok {
+		for _, prop := range props {
+			if sub, ok := prop.(map[string]interface{}); ok {
+				translateSchema(sub)
+			}
+		}
+	}
+	for _, key := range []string{"items", "additionalProperties", "not"} {
+		if sub, ok := schema[key].(map[string]interface{}); ok {
+			translateSchema(sub)
+		}
+	}
+	for _, key := range []string{"allOf", "anyOf", "oneOf"} {
+		if subs, ok := schema[key].([]interface{}); ok {
+			for _, s := range subs {
+				if sub, ok := s.(map[string]interface{}); ok {
+					translateSchema(sub)
+				}
+			}
+		}
+	}
+}
+
+// requireDefaultedProperties makes branches that exclude the default value of
+// an optional property of schema require the property. The API server applies
+// defaults before validating, while JSON Schema validators do not, so an
+// absent property must only match the branches its default matches, e.g. the
+// IPAddress branch of <gateway:validateIPAddress> for an address without type.
+func requireDefaultedProperties(schema map[string]interface{}, branches []interface{}) {
+	props, _ := schema["properties"].(map[string]interface{})
+	for _, b := range branches {
+		branch, _ := b.(map[string]interface{})
+		branchProps, _ := branch["properties"].(map[string]interface{})
+		for name, c := range branchProps {
+			prop, _ := props[name].(map[string]interface{})
+			def, hasDefault := prop["default"]
+			constraint, _ := c.(map[string]interface{})
+			if !hasDefault || constraint == nil {
+				continue
+			}
+
+			excluded := false
+			if enum, ok := constraint["enum"].([]interface{}); ok {
+				excluded = !contains(enum, def)
+			}
+			if not, ok := constraint["not"].(map[string]interface{}); ok {
+				if enum, ok := not["enum"].([]interface{}); ok {
+					excluded = contains(enum, def)
+				}
+			}
+			if excluded {
+				required, _ := branch["required"].([]interface{})
+				branch["required"] = append(required, name)
+			}
+		}
+	}
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
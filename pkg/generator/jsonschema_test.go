@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestToJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema apiext.JSONSchemaProps
+		want   string
+	}{{
+		name: "nullable",
+		schema: apiext.JSONSchemaProps{
+			Type:     "string",
+			Nullable: true,
+		},
+		want: `{"type":["string","null"]}`,
+	}, {
+		name: "objects with properties are closed",
+		schema: apiext.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiext.JSONSchemaProps{
+				"closed": {Type: "object", Properties: map[string]apiext.JSONSchemaProps{"a": {Type: "string"}}},
+				"open":   {Type: "object"},
+				"preserved": {
+					Type:                   "object",
+					Properties:             map[string]apiext.JSONSchemaProps{"a": {Type: "string"}},
+					XPreserveUnknownFields: ptrTo(true),
+				},
+			},
+		},
+		want: `{"type":"object","additionalProperties":false,"properties":{
+			"closed":{"type":"object","additionalProperties":false,"properties":{"a":{"type":"string"}}},
+			"open":{"type":"object"},
+			"preserved":{"type":"object","properties":{"a":{"type":"string"}},"x-kubernetes-preserve-unknown-fields":true}}}`,
+	}, {
+		name: "int or string",
+		schema: apiext.JSONSchemaProps{
+			XIntOrString: true,
+		},
+		want: `{"anyOf":[{"type":"integer"},{"type":"string"}],"x-kubernetes-int-or-string":true}`,
+	}, {
+		name: "set",
+		schema: apiext.JSONSchemaProps{
+			Type:      "array",
+			Items:     &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{Type: "string"}},
+			XListType: ptrTo("set"),
+		},
+		want: `{"type":"array","items":{"type":"string"},"uniqueItems":true,"x-kubernetes-list-type":"set"}`,
+	}, {
+		name: "branches excluding the default require the property",
+		schema: apiext.JSONSchemaProps{
+			Type: "object",
+			Properties: map[string]apiext.JSONSchemaProps{
+				"type": {Type: "string", Default: &apiext.JSON{Raw: []byte(`"IPAddress"`)}},
+			},
+			OneOf: []apiext.JSONSchemaProps{{
+				Properties: map[string]apiext.JSONSchemaProps{
+					"type": {Enum: []apiext.JSON{{Raw: []byte(`"IPAddress"`)}}},
+				},
+			}, {
+				Properties: map[string]apiext.JSONSchemaProps{
+					"type": {Not: &apiext.JSONSchemaProps{Enum: []apiext.JSON{{Raw: []byte(`"IPAddress"`)}}}},
+				},
+			}},
+		},
+		want: `{"type":"object","additionalProperties":false,
+			"properties":{"type":{"type":"string","default":"IPAddress"}},
+			"oneOf":[
+				{"properties":{"type":{"enum":["IPAddress"]}}},
+				{"properties":{"type":{"not":{"enum":["IPAddress"]}}},"required":["type"]}]}`,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := toJSONSchema(&tc.schema)
+			require.NoError(t, err)
+			gotJSON, err := json.Marshal(got)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.want, string(gotJSON))
+		})
+	}
+}
+
+func TestWriteJSONSchemasSkipsUnservedVersions(t *testing.T) {
+	schema := &apiext.CustomResourceValidation{OpenAPIV3Schema: &apiext.JSONSchemaProps{Type: "object"}}
+	crd := &apiext.CustomResourceDefinition{
+		Spec: apiext.CustomResourceDefinitionSpec{
+			Group: "gateway.networking.k8s.io",
+			Names: apiext.CustomResourceDefinitionNames{Kind: "GRPCRoute"},
+			Versions: []apiext.CustomResourceDefinitionVersion{
+				{Name: "v1alpha2", Served: false, Schema: schema},
+				{Name: "v1beta1", Served: true, Storage: true, Schema: schema},
+			},
+		},
+	}
+
+	dir := t.TempDir()
+	entries, err := writeJSONSchemas(dir, crd)
+	require.NoError(t, err)
+	assert.Equal(t, []jsonSchemaIndexEntry{{
+		APIVersion: "gateway.networking.k8s.io/v1beta1",
+		Kind:       "GRPCRoute",
+		Schema:     "grpcroute_v1beta1.json",
+	}}, entries)
+	assert.NoFileExists(t, filepath.Join(dir, "grpcroute_v1alpha2.json"))
+	assert.FileExists(t, filepath.Join(dir, "grpcroute_v1beta1.json"))
+}
@@ -17,38 +17,53 @@ limitations under the License.
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
-	"regexp"
-	"strings"
 
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-tools/pkg/crd"
 	"sigs.k8s.io/controller-tools/pkg/loader"
 	"sigs.k8s.io/controller-tools/pkg/markers"
 	"sigs.k8s.io/yaml"
-)
 
-const (
-	bundleVersionAnnotation = "gateway.networking.k8s.io/bundle-version"
-	channelAnnotation       = "gateway.networking.k8s.io/channel"
+	"sigs.k8s.io/gateway-api/pkg/consts"
+)
 
-	// These values must be updated during the release process
-	bundleVersion = "v0.8.0-rc1"
-	approvalLink  = "https://github.com/kubernetes-sigs/gateway-api/pull/2245"
+var (
+	bundleVersion = flag.String("bundle-version", consts.BundleVersion(), "Bundle version to stamp into the CRDs, defaults to the version of pkg/consts")
+	// The default must be updated when the API is reviewed again.
+	approvalLink = flag.String("approval-link", "https://github.com/kubernetes-sigs/gateway-api/pull/2245", "Link to the API review approving the CRDs")
+	// The CRDs use the None conversion strategy unless this is set, which only
+	// preserves data while the schemas of all versions are identical.
+	conversionWebhookService = flag.String("conversion-webhook-service", "", "namespace/name of the admission server Service to convert objects between CRD versions with, e.g. gateway-system/gateway-api-admission-server")
 )
 
 var standardKinds = map[string]bool{
 	"GatewayClass":   true,
 	"Gateway":        true,
+	"GRPCRoute":      true,
 	"HTTPRoute":      true,
 	"ReferenceGrant": true,
 }
 
+// standardUnservedVersions are the versions of standard kinds that are only
+// served in the experimental channel, like +kubebuilder:unservedversion for a
+// single channel. These are versions which were never part of the standard
+// channel, so no objects were stored in them.
+var standardUnservedVersions = map[string][]string{
+	"GRPCRoute": {"v1alpha2"},
+}
+
 // This generation code is largely copied from
 // github.com/kubernetes-sigs/controller-tools/blob/ab52f76cc7d167925b2d5942f24bf22e30f49a02/pkg/crd/gen.go
 func main() {
+	flag.Parse()
+	if *bundleVersion == "" {
+		log.Fatalf("the bundle version must not be empty")
+	}
+
 	roots, err := loader.LoadRoots(
 		"k8s.io/apimachinery/pkg/runtime/schema", // Needed to parse generated register functions.
 		"sigs.k8s.io/gateway-api/apis/v1alpha2",
@@ -87,8 +102,16 @@ func main() {
 		log.Fatalf("no objects in the roots")
 	}
 
-	channels := []string{"standard", "experimental"}
+	// channelCRDs holds the generated CRDs of every channel by kind.
+	channelCRDs := map[string]map[string]*apiext.CustomResourceDefinition{}
 	for _, channel := range channels {
+		channelCRDs[channel] = map[string]*apiext.CustomResourceDefinition{}
+		jsonSchemaDir := fmt.Sprintf("config/jsonschema/%s", channel)
+		if err := os.MkdirAll(jsonSchemaDir, 0o755); err != nil {
+			log.Fatalf("failed to create JSON Schema directory: %s", err)
+		}
+		var jsonSchemas []jsonSchemaIndexEntry
+
 		for _, groupKind := range kubeKinds {
 			if channel == "standard" && !standardKinds[groupKind.Kind] {
 				continue
@@ -103,16 +126,32 @@ func main() {
 			if crdRaw.ObjectMeta.Annotations == nil {
 				crdRaw.ObjectMeta.Annotations = map[string]string{}
 			}
-			crdRaw.ObjectMeta.Annotations[bundleVersionAnnotation] = bundleVersion
-			crdRaw.ObjectMeta.Annotations[channelAnnotation] = channel
-			crdRaw.ObjectMeta.Annotations[apiext.KubeAPIApprovedAnnotation] = approvalLink
+			crdRaw.ObjectMeta.Annotations[consts.BundleVersionAnnotation] = *bundleVersion
+			crdRaw.ObjectMeta.Annotations[consts.ChannelAnnotation] = channel
+			crdRaw.ObjectMeta.Annotations[apiext.KubeAPIApprovedAnnotation] = *approvalLink
 
 			// Prevent the top level metadata for the CRD to be generated regardless of the intention in the arguments
 			crd.FixTopLevelMetadata(crdRaw)
 
 			channelCrd := crdRaw.DeepCopy()
+			if *conversionWebhookService != "" {
+				if err := setConversionWebhook(channelCrd, *conversionWebhookService); err != nil {
+					log.Fatalf("failed to set the conversion webhook of %s: %s", groupKind.Kind, err)
+				}
+			}
+			if channel == "standard" {
+				if err := unserveVersions(channelCrd, standardUnservedVersions[groupKind.Kind]); err != nil {
+					log.Fatalf("failed to unserve versions of %s: %s", groupKind.Kind, err)
+				}
+			}
 			for _, version := range channelCrd.Spec.Versions {
-				version.Schema.OpenAPIV3Schema.Properties = gatewayTweaks(channel, version.Schema.OpenAPIV3Schema.Properties)
+				keep, err := applyMarkers(channel, version.Schema.OpenAPIV3Schema, groupKind.Kind)
+				if err != nil {
+					log.Fatalf("failed to apply Gateway markers to %s %s: %s", version.Name, groupKind.Kind, err)
+				}
+				if !keep {
+					log.Fatalf("%s %s is marked experimental but is part of the %s channel", version.Name, groupKind.Kind, channel)
+				}
 			}
 
 			conv, err := crd.AsVersion(*channelCrd, apiext.SchemeGroupVersion)
@@ -130,98 +169,21 @@ func main() {
 			if err != nil {
 				log.Fatalf("failed to write CRD: %s", err)
 			}
-		}
-	}
-}
-
-// Custom Gateway API Tweaks for tags prefixed with `<gateway:` that get past
-// the limitations of Kubebuilder annotations.
-func gatewayTweaks(channel string, props map[string]apiext.JSONSchemaProps) map[string]apiext.JSONSchemaProps {
-	for name := range props {
-		jsonProps, _ := props[name]
-
-		if strings.Contains(jsonProps.Description, "<gateway:validateIPAddress>") {
-			jsonProps.Items.Schema.OneOf = []apiext.JSONSchemaProps{{
-				Properties: map[string]apiext.JSONSchemaProps{
-					"type": {
-						Enum: []apiext.JSON{{Raw: []byte("\"IPAddress\"")}},
-					},
-					"value": {
-						AnyOf: []apiext.JSONSchemaProps{{
-							Format: "ipv4",
-						}, {
-							Format: "ipv6",
-						}},
-					},
-				},
-			}, {
-				Properties: map[string]apiext.JSONSchemaProps{
-					"type": {
-						Not: &apiext.JSONSchemaProps{
-							Enum: []apiext.JSON{{Raw: []byte("\"IPAddress\"")}},
-						},
-					},
-				},
-			}}
-		}
-
-		if channel == "standard" && strings.Contains(jsonProps.Description, "<gateway:experimental>") {
-			delete(props, name)
-			continue
-		}
-
-		// TODO(robscott): Figure out why crdgen switched this to "object"
-		if jsonProps.Format == "date-time" {
-			jsonProps.Type = "string"
-		}
-
-		validationPrefix := fmt.Sprintf("<gateway:%s:validation:", channel)
-		numExpressions := strings.Count(jsonProps.Description, validationPrefix)
-		numValid := 0
-		if numExpressions > 0 {
-			enumRe := regexp.MustCompile(validationPrefix + "Enum=([A-Za-z;]*)>")
-			enumMatches := enumRe.FindAllStringSubmatch(jsonProps.Description, 64)
-			for _, enumMatch := range enumMatches {
-				if len(enumMatch) != 2 {
-					log.Fatalf("Invalid %s Enum tag for %s", validationPrefix, name)
-				}
-
-				numValid++
-				jsonProps.Enum = []apiext.JSON{}
-				for _, val := range strings.Split(enumMatch[1], ";") {
-					jsonProps.Enum = append(jsonProps.Enum, apiext.JSON{Raw: []byte("\"" + val + "\"")})
-				}
-			}
-
-			celRe := regexp.MustCompile(validationPrefix + "XValidation:message=\"([^\"]*)\",rule=\"([^\"]*)\">")
-			celMatches := celRe.FindAllStringSubmatch(jsonProps.Description, 64)
-			for _, celMatch := range celMatches {
-				if len(celMatch) != 3 {
-					log.Fatalf("Invalid %s CEL tag for %s", validationPrefix, name)
-				}
 
-				numValid++
-				jsonProps.XValidations = append(jsonProps.XValidations, apiext.ValidationRule{
-					Message: celMatch[1],
-					Rule:    celMatch[2],
-				})
+			entries, err := writeJSONSchemas(jsonSchemaDir, channelCrd)
+			if err != nil {
+				log.Fatalf("failed to write JSON Schemas: %s", err)
 			}
+			jsonSchemas = append(jsonSchemas, entries...)
+			channelCRDs[channel][groupKind.Kind] = channelCrd
 		}
 
-		if numValid < numExpressions {
-			fmt.Printf("Description: %s\n", jsonProps.Description)
-			log.Fatalf("Found %d Gateway validation expressions, but only %d were valid", numExpressions, numValid)
+		if err := writeJSONSchemaIndex(jsonSchemaDir, channel, jsonSchemas); err != nil {
+			log.Fatalf("failed to write JSON Schema index: %s", err)
 		}
+	}
 
-		gatewayRe := regexp.MustCompile(`<gateway:.*>`)
-		jsonProps.Description = gatewayRe.ReplaceAllLiteralString(jsonProps.Description, "")
-
-		if len(jsonProps.Properties) > 0 {
-			jsonProps.Properties = gatewayTweaks(channel, jsonProps.Properties)
-		} else if jsonProps.Items != nil && jsonProps.Items.Schema != nil {
-			jsonProps.Items.Schema.Properties = gatewayTweaks(channel, jsonProps.Items.Schema.Properties)
-		}
-		props[name] = jsonProps
+	if err := writeChannelFields(channelCRDs["standard"], channelCRDs["experimental"]); err != nil {
+		log.Fatalf("failed to write experimental fields: %s", err)
 	}
-	return props
 }
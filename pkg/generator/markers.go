@@ -0,0 +1,385 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// Gateway markers are written in Go doc comments, and therefore end up in the
+// descriptions of the generated schemas, where they are parsed and removed.
+// They get past the limitations of Kubebuilder markers, chiefly that these
+// cannot differ between release channels. The supported markers are:
+//
+//	<gateway:experimental>
+//	    The field is only part of the experimental channel.
+//	<gateway:validateIPAddress>
+//	    The items of the list must be valid IP addresses if their type is
+//	    IPAddress.
+//	<gateway:CHANNEL:validation:Enum=A;B>
+//	<gateway:CHANNEL:validation:XValidation:message="...",rule="...">
+//	<gateway:CHANNEL:validation:MaxItems=N>
+//	<gateway:CHANNEL:default=JSON>
+//	    Like the Kubebuilder markers of the same name, in CHANNEL only.
+//	<gateway:CHANNEL:description>...</gateway:CHANNEL:description>
+//	    The enclosed text is only part of the description in CHANNEL.
+//
+// CHANNEL is one of the release channels. Unknown markers and channels are
+// errors rather than being silently dropped.
+const markerPrefix = "<gateway:"
+
+var channels = []string{"standard", "experimental"}
+
+// marker is a parsed Gateway marker.
+type marker struct {
+	// channel is the channel the marker applies to, empty for all channels.
+	channel string
+	// name is the marker name without channel, e.g. "validation:Enum".
+	name string
+	// value is the text after "=", if any.
+	value string
+	// closing is true for the closing marker of a description block.
+	closing bool
+}
+
+// parseMarker parses the marker text between "<gateway:" and ">".
+func parseMarker(text string, closing bool) (marker, error) {
+	name, value, hasValue := strings.Cut(text, "=")
+	// The arguments of XValidation follow a ":" and contain "=" themselves.
+	if i := strings.Index(text, ":validation:XValidation:"); i >= 0 {
+		name, value, hasValue = text[:i+len(":validation:XValidation")], text[i+len(":validation:XValidation:"):], true
+	}
+
+	m := marker{name: name, value: value, closing: closing}
+	if prefix, rest, ok := strings.Cut(name, ":"); ok {
+		if !isChannel(prefix) {
+			return m, fmt.Errorf("unknown channel %q in marker <gateway:%s>", prefix, text)
+		}
+		m.channel, m.name = prefix, rest
+	}
+
+	var needsValue bool
+	switch {
+	case m.channel == "" && (m.name == "experimental" || m.name == "validateIPAddress"):
+	case m.channel != "" && m.name == "description":
+	case m.channel != "" && (m.name == "validation:Enum" || m.name == "validation:XValidation" ||
+		m.name == "validation:MaxItems" || m.name == "default"):
+		needsValue = true
+	default:
+		return m, fmt.Errorf("unknown marker <gateway:%s>", text)
+	}
+	if closing && m.name != "description" {
+		return m, fmt.Errorf("unexpected closing marker </gateway:%s>", text)
+	}
+	if needsValue != hasValue {
+		if needsValue {
+			return m, fmt.Errorf("marker <gateway:%s> requires a value", text)
+		}
+		return m, fmt.Errorf("marker <gateway:%s> does not take a value", text)
+	}
+	return m, nil
+}
+
+func isChannel(name string) bool {
+	for _, channel := range channels {
+		if name == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// extractMarkers returns the markers of description, and description with the
+// markers and the description blocks of channels other than channel removed.
+func extractMarkers(channel, description string) (string, []marker, error) {
+	var (
+		markers []marker
+		out     strings.Builder
+		// block is the open description block, if any.
+		block       *marker
+		afterMarker bool
+	)
+	rest := description
+	for {
+		start := strings.Index(rest, markerPrefix)
+		closeStart := strings.Index(rest, "</gateway:")
+		closing := closeStart >= 0 && (start < 0 || closeStart < start)
+		if closing {
+			start = closeStart
+		}
+		if start < 0 {
+			break
+		}
+
+		// Whitespace between consecutive markers is dropped with them.
+		between := rest[:start]
+		if (block == nil || block.channel == channel) && !(afterMarker && strings.TrimSpace(between) == "") {
+			out.WriteString(between)
+		}
+		afterMarker = true
+		rest = rest[start:]
+		if closing {
+			rest = rest[len("</gateway:"):]
+		} else {
+			rest = rest[len(markerPrefix):]
+		}
+
+		end := markerEnd(rest)
+		if end < 0 {
+			return "", nil, fmt.Errorf("unterminated marker %q", truncate(description[len(description)-len(rest)-len(markerPrefix):]))
+		}
+		m, err := parseMarker(rest[:end], closing)
+		if err != nil {
+			return "", nil, err
+		}
+		rest = rest[end+1:]
+
+		switch {
+		case m.name == "description" && !m.closing:
+			if block != nil {
+				return "", nil, fmt.Errorf("nested description block for channel %s", m.channel)
+			}
+			block = &m
+		case m.closing:
+			if block == nil || block.channel != m.channel {
+				return "", nil, fmt.Errorf("closing marker for channel %s without matching opening marker", m.channel)
+			}
+			block = nil
+		default:
+			if block != nil {
+				return "", nil, fmt.Errorf("marker <gateway:%s> inside description block", m.name)
+			}
+			markers = append(markers, m)
+		}
+	}
+	if block != nil {
+		return "", nil, fmt.Errorf("unterminated description block for channel %s", block.channel)
+	}
+	out.WriteString(rest)
+	return out.String(), markers, nil
+}
+
+// markerEnd returns the index of the ">" ending the marker text at the start
+// of s, skipping quoted strings, which may contain ">" in CEL rules.
+func markerEnd(s string) int {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quoted {
+				i++
+			}
+		case '"':
+			quoted = !quoted
+		case '>':
+			if !quoted {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func truncate(s string) string {
+	if len(s) > 64 {
+		return s[:64] + "..."
+	}
+	return s
+}
+
+// applyMarkers applies the markers in the descriptions of schema and its
+// children for channel. It returns false if schema is not part of channel.
+func applyMarkers(channel string, schema *apiext.JSONSchemaProps, path string) (bool, error) {
+	description, markers, err := extractMarkers(channel, schema.Description)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", path, err)
+	}
+	schema.Description = description
+
+	for _, m := range markers {
+		if m.name == "experimental" && channel != "experimental" {
+			return false, nil
+		}
+	}
+	for _, m := range markers {
+		if m.channel != "" && m.channel != channel {
+			continue
+		}
+		if err := applyMarker(m, schema); err != nil {
+			return false, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	// TODO(robscott): Figure out why crdgen switched this to "object"
+	if schema.Format == "date-time" {
+		schema.Type = "string"
+	}
+
+	for name := range schema.Properties {
+		prop := schema.Properties[name]
+		keep, err := applyMarkers(channel, &prop, path+"."+name)
+		if err != nil {
+			return false, err
+		}
+		if keep {
+			schema.Properties[name] = prop
+		} else {
+			delete(schema.Properties, name)
+			schema.Required = without(schema.Required, name)
+		}
+	}
+
+	// Lists and maps of values that are not part of the channel are not part
+	// of it either.
+	if schema.Items != nil && schema.Items.Schema != nil {
+		keep, err := applyMarkers(channel, schema.Items.Schema, path+"[*]")
+		if err != nil || !keep {
+			return false, err
+		}
+	}
+	if schema.AdditionalProperties != nil && schema.AdditionalProperties.Schema != nil {
+		keep, err := applyMarkers(channel, schema.AdditionalProperties.Schema, path+"{*}")
+		if err != nil || !keep {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func applyMarker(m marker, schema *apiext.JSONSchemaProps) error {
+	switch m.name {
+	case "validateIPAddress":
+		if schema.Items == nil || schema.Items.Schema == nil {
+			return fmt.Errorf("<gateway:validateIPAddress> requires a list")
+		}
+		schema.Items.Schema.OneOf = []apiext.JSONSchemaProps{{
+			Properties: map[string]apiext.JSONSchemaProps{
+				"type": {
+					Enum: []apiext.JSON{{Raw: []byte("\"IPAddress\"")}},
+				},
+				"value": {
+					AnyOf: []apiext.JSONSchemaProps{{
+						Format: "ipv4",
+					}, {
+						Format: "ipv6",
+					}},
+				},
+			},
+		}, {
+			Properties: map[string]apiext.JSONSchemaProps{
+				"type": {
+					Not: &apiext.JSONSchemaProps{
+						Enum: []apiext.JSON{{Raw: []byte("\"IPAddress\"")}},
+					},
+				},
+			},
+		}}
+	case "validation:Enum":
+		schema.Enum = []apiext.JSON{}
+		for _, val := range strings.Split(m.value, ";") {
+			raw, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+			schema.Enum = append(schema.Enum, apiext.JSON{Raw: raw})
+		}
+	case "validation:XValidation":
+		rule, err := parseValidationRule(m.value)
+		if err != nil {
+			return err
+		}
+		schema.XValidations = append(schema.XValidations, rule)
+	case "validation:MaxItems":
+		if schema.Type != "array" {
+			return fmt.Errorf("<gateway:%s:validation:MaxItems> requires a list", m.channel)
+		}
+		maxItems, err := strconv.ParseInt(m.value, 10, 64)
+		if err != nil || maxItems < 0 {
+			return fmt.Errorf("invalid MaxItems value %q", m.value)
+		}
+		schema.MaxItems = &maxItems
+	case "default":
+		if !json.Valid([]byte(m.value)) {
+			return fmt.Errorf("invalid default value %q, must be JSON", m.value)
+		}
+		schema.Default = &apiext.JSON{Raw: []byte(m.value)}
+	}
+	return nil
+}
+
+// parseValidationRule parses the comma-separated key="value" arguments of an
+// XValidation marker.
+func parseValidationRule(args string) (apiext.ValidationRule, error) {
+	var rule apiext.ValidationRule
+	rest := args
+	for rest != "" {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok || !strings.HasPrefix(value, `"`) {
+			return rule, fmt.Errorf("invalid XValidation arguments %q", truncate(args))
+		}
+		end := closingQuote(value[1:])
+		if end < 0 {
+			return rule, fmt.Errorf("unterminated value of %s in XValidation arguments", key)
+		}
+		// Values are taken verbatim, e.g. matches('^\d+$'), except for
+		// escaped quotes.
+		unquoted := strings.ReplaceAll(value[1:end+1], `\"`, `"`)
+		switch key {
+		case "rule":
+			rule.Rule = unquoted
+		case "message":
+			rule.Message = unquoted
+		case "messageExpression":
+			rule.MessageExpression = unquoted
+		default:
+			return rule, fmt.Errorf("unknown XValidation argument %q", key)
+		}
+		rest = strings.TrimPrefix(value[end+2:], ",")
+	}
+	if rule.Rule == "" {
+		return rule, fmt.Errorf("XValidation marker requires a rule")
+	}
+	return rule, nil
+}
+
+// closingQuote returns the index of the first unescaped '"' in s.
+func closingQuote(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+func without(list []string, value string) []string {
+	var out []string
+	for _, v := range list {
+		if v != value {
+			out = append(out, v)
+		}
+	}
+	return out
+}
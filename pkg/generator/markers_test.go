@@ -0,0 +1,180 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func objectWith(props map[string]apiext.JSONSchemaProps) *apiext.JSONSchemaProps {
+	return &apiext.JSONSchemaProps{Type: "object", Properties: props}
+}
+
+func TestApplyMarkers(t *testing.T) {
+	tests := []struct {
+		name    string
+		channel string
+		schema  *apiext.JSONSchemaProps
+		want    *apiext.JSONSchemaProps
+		wantErr string
+	}{{
+		name:    "experimental field in standard",
+		channel: "standard",
+		schema: &apiext.JSONSchemaProps{
+			Type:     "object",
+			Required: []string{"a", "b"},
+			Properties: map[string]apiext.JSONSchemaProps{
+				"a": {Type: "string", Description: "A. <gateway:experimental>"},
+				"b": {Type: "string", Description: "B."},
+			},
+		},
+		want: &apiext.JSONSchemaProps{
+			Type:     "object",
+			Required: []string{"b"},
+			Properties: map[string]apiext.JSONSchemaProps{
+				"b": {Type: "string", Description: "B."},
+			},
+		},
+	}, {
+		name:    "experimental field in experimental",
+		channel: "experimental",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "A. <gateway:experimental>"},
+		}),
+		want: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "A. "},
+		}),
+	}, {
+		name:    "experimental list items",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "array", Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+				Type: "object", Description: "Item. <gateway:experimental>",
+			}}},
+		}),
+		want: objectWith(map[string]apiext.JSONSchemaProps{}),
+	}, {
+		name:    "experimental map values",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "object", AdditionalProperties: &apiext.JSONSchemaPropsOrBool{Schema: &apiext.JSONSchemaProps{
+				Type: "string", Description: "<gateway:experimental>",
+			}}},
+		}),
+		want: objectWith(map[string]apiext.JSONSchemaProps{}),
+	}, {
+		name:    "channel-specific validation",
+		channel: "experimental",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {
+				Type:        "array",
+				Description: `A. <gateway:standard:validation:MaxItems=4> <gateway:experimental:validation:MaxItems=8> <gateway:experimental:validation:XValidation:message="must not be empty",rule="size(self) > 0">`,
+				Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+					Type:        "string",
+					Description: "<gateway:experimental:validation:Enum=Foo;Bar> <gateway:experimental:default=\"Foo\">",
+				}},
+			},
+		}),
+		want: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {
+				Type:         "array",
+				Description:  "A. ",
+				MaxItems:     ptrTo[int64](8),
+				XValidations: []apiext.ValidationRule{{Message: "must not be empty", Rule: "size(self) > 0"}},
+				Items: &apiext.JSONSchemaPropsOrArray{Schema: &apiext.JSONSchemaProps{
+					Type:    "string",
+					Enum:    []apiext.JSON{{Raw: []byte(`"Foo"`)}, {Raw: []byte(`"Bar"`)}},
+					Default: &apiext.JSON{Raw: []byte(`"Foo"`)},
+				}},
+			},
+		}),
+	}, {
+		name:    "channel-specific description",
+		channel: "standard",
+		schema: &apiext.JSONSchemaProps{
+			Type:        "object",
+			Description: "Root. <gateway:experimental:description>Experimental. </gateway:experimental:description><gateway:standard:description>Standard.</gateway:standard:description>",
+		},
+		want: &apiext.JSONSchemaProps{
+			Type:        "object",
+			Description: "Root. Standard.",
+		},
+	}, {
+		name:    "typo in marker",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "<gateway:experimantal>"},
+		}),
+		wantErr: "root.a: unknown marker <gateway:experimantal>",
+	}, {
+		name:    "unknown channel",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "array", Description: "<gateway:stable:validation:MaxItems=4>"},
+		}),
+		wantErr: `unknown channel "stable"`,
+	}, {
+		name:    "invalid marker in other channel",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "<gateway:experimental:validation:Enum>"},
+		}),
+		wantErr: "requires a value",
+	}, {
+		name:    "MaxItems on non-list",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "<gateway:standard:validation:MaxItems=4>"},
+		}),
+		wantErr: "requires a list",
+	}, {
+		name:    "invalid default",
+		channel: "standard",
+		schema: objectWith(map[string]apiext.JSONSchemaProps{
+			"a": {Type: "string", Description: "<gateway:standard:default=Foo>"},
+		}),
+		wantErr: "must be JSON",
+	}, {
+		name:    "unterminated description block",
+		channel: "standard",
+		schema:  &apiext.JSONSchemaProps{Description: "<gateway:standard:description>Standard."},
+		wantErr: "unterminated description block",
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keep, err := applyMarkers(tc.channel, tc.schema, "root")
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), tc.wantErr), "expected error containing %q, got %v", tc.wantErr, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, keep)
+			assert.Equal(t, tc.want, tc.schema)
+		})
+	}
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
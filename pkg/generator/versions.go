@@ -0,0 +1,47 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// unserveVersions stops the API server from serving the named versions of
+// crd. The storage version must stay served, and every name must be a version
+// of crd, so that a renamed version is not silently served again.
+func unserveVersions(crd *apiext.CustomResourceDefinition, names []string) error {
+	for _, name := range names {
+		found := false
+		for i := range crd.Spec.Versions {
+			version := &crd.Spec.Versions[i]
+			if version.Name != name {
+				continue
+			}
+			if version.Storage {
+				return fmt.Errorf("storage version %s must be served", name)
+			}
+			version.Served = false
+			found = true
+		}
+		if !found {
+			return fmt.Errorf("unknown version %s", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestUnserveVersions(t *testing.T) {
+	newCRD := func() *apiext.CustomResourceDefinition {
+		return &apiext.CustomResourceDefinition{
+			Spec: apiext.CustomResourceDefinitionSpec{
+				Versions: []apiext.CustomResourceDefinitionVersion{
+					{Name: "v1alpha2", Served: true},
+					{Name: "v1beta1", Served: true, Storage: true},
+				},
+			},
+		}
+	}
+
+	crd := newCRD()
+	require.NoError(t, unserveVersions(crd, nil))
+	assert.True(t, crd.Spec.Versions[0].Served)
+
+	crd = newCRD()
+	require.NoError(t, unserveVersions(crd, []string{"v1alpha2"}))
+	assert.False(t, crd.Spec.Versions[0].Served)
+	assert.True(t, crd.Spec.Versions[1].Served)
+
+	assert.Error(t, unserveVersions(newCRD(), []string{"v1beta1"}))
+	assert.Error(t, unserveVersions(newCRD(), []string{"v1"}))
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package indexers provides cache.Indexers and lookup helpers for the
+// lookups that Gateway API controllers commonly perform against the
+// informers in pkg/client/informers. Both the v1alpha2 and v1beta1 versions
+// of every kind are supported.
+package indexers
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// ParentGatewayIndex indexes routes by the namespace/name of every
+	// Gateway referenced from spec.parentRefs.
+	ParentGatewayIndex = "gateway.networking.k8s.io/parent-gateway"
+
+	// BackendServiceIndex indexes routes by the namespace/name of every
+	// Service referenced from the backendRefs of their rules, including the
+	// backendRefs of RequestMirror filters.
+	BackendServiceIndex = "gateway.networking.k8s.io/backend-service"
+
+	// GatewayClassIndex indexes Gateways by spec.gatewayClassName.
+	GatewayClassIndex = "gateway.networking.k8s.io/gateway-class"
+
+	// CertificateSecretIndex indexes Gateways by the namespace/name of every
+	// Secret referenced from the TLS certificateRefs of their listeners.
+	CertificateSecretIndex = "gateway.networking.k8s.io/certificate-secret"
+
+	// ReferenceGrantFromNamespaceIndex indexes ReferenceGrants by the
+	// namespace of every entry in spec.from.
+	ReferenceGrantFromNamespaceIndex = "gateway.networking.k8s.io/referencegrant-from-namespace"
+
+	// ReferenceGrantToKindIndex indexes ReferenceGrants by the group and
+	// kind of every entry in spec.to, formatted as a schema.GroupKind.
+	ReferenceGrantToKindIndex = "gateway.networking.k8s.io/referencegrant-to-kind"
+)
+
+const (
+	gatewayKind = "Gateway"
+	secretKind  = "Secret"
+	serviceKind = "Service"
+)
+
+// RouteIndexers returns the indexers for any route informer.
+func RouteIndexers() cache.Indexers {
+	return cache.Indexers{
+		ParentGatewayIndex:  ParentGatewayIndexFunc,
+		BackendServiceIndex: BackendServiceIndexFunc,
+	}
+}
+
+// GatewayIndexers returns the indexers for a Gateway informer.
+func GatewayIndexers() cache.Indexers {
+	return cache.Indexers{
+		GatewayClassIndex:      GatewayClassIndexFunc,
+		CertificateSecretIndex: CertificateSecretIndexFunc,
+	}
+}
+
+// ReferenceGrantIndexers returns the indexers for a ReferenceGrant informer.
+func ReferenceGrantIndexers() cache.Indexers {
+	return cache.Indexers{
+		ReferenceGrantFromNamespaceIndex: ReferenceGrantFromNamespaceIndexFunc,
+		ReferenceGrantToKindIndex:        ReferenceGrantToKindIndexFunc,
+	}
+}
+
+// ParentGatewayIndexFunc is the cache.IndexFunc for ParentGatewayIndex.
+func ParentGatewayIndexFunc(obj interface{}) ([]string, error) {
+	namespace, parentRefs, err := routeParentRefs(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, ref := range parentRefs {
+		if ref.Group != nil && *ref.Group != v1beta1.GroupName {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != gatewayKind {
+			continue
+		}
+		keys = appendUnique(keys, namespacedKey(ref.Namespace, namespace, string(ref.Name)))
+	}
+	return keys, nil
+}
+
+// BackendServiceIndexFunc is the cache.IndexFunc for BackendServiceIndex.
+func BackendServiceIndexFunc(obj interface{}) ([]string, error) {
+	namespace, backendRefs, err := routeBackendRefs(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, ref := range backendRefs {
+		if ref.Group != nil && *ref.Group != "" {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != serviceKind {
+			continue
+		}
+		keys = appendUnique(keys, namespacedKey(ref.Namespace, namespace, string(ref.Name)))
+	}
+	return keys, nil
+}
+
+// GatewayClassIndexFunc is the cache.IndexFunc for GatewayClassIndex.
+func GatewayClassIndexFunc(obj interface{}) ([]string, error) {
+	switch gw := obj.(type) {
+	case *v1beta1.Gateway:
+		return []string{string(gw.Spec.GatewayClassName)}, nil
+	case *v1alpha2.Gateway:
+		return []string{string(gw.Spec.GatewayClassName)}, nil
+	default:
+		return nil, fmt.Errorf("expected Gateway, got %T", obj)
+	}
+}
+
+// CertificateSecretIndexFunc is the cache.IndexFunc for
+// CertificateSecretIndex.
+func CertificateSecretIndexFunc(obj interface{}) ([]string, error) {
+	var namespace string
+	var listeners []v1beta1.Listener
+	switch gw := obj.(type) {
+	case *v1beta1.Gateway:
+		namespace, listeners = gw.Namespace, gw.Spec.Listeners
+	case *v1alpha2.Gateway:
+		namespace, listeners = gw.Namespace, gw.Spec.Listeners
+	default:
+		return nil, fmt.Errorf("expected Gateway, got %T", obj)
+	}
+
+	var keys []string
+	for _, listener := range listeners {
+		if listener.TLS == nil {
+			continue
+		}
+		for _, ref := range listener.TLS.CertificateRefs {
+			if ref.Group != nil && *ref.Group != "" {
+				continue
+			}
+			if ref.Kind != nil && *ref.Kind != secretKind {
+				continue
+			}
+			keys = appendUnique(keys, namespacedKey(ref.Namespace, namespace, string(ref.Name)))
+		}
+	}
+	return keys, nil
+}
+
+// ReferenceGrantFromNamespaceIndexFunc is the cache.IndexFunc for
+// ReferenceGrantFromNamespaceIndex.
+func ReferenceGrantFromNamespaceIndexFunc(obj interface{}) ([]string, error) {
+	spec, err := referenceGrantSpec(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, from := range spec.From {
+		keys = appendUnique(keys, string(from.Namespace))
+	}
+	return keys, nil
+}
+
+// ReferenceGrantToKindIndexFunc is the cache.IndexFunc for
+// ReferenceGrantToKindIndex.
+func ReferenceGrantToKindIndexFunc(obj interface{}) ([]string, error) {
+	spec, err := referenceGrantSpec(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, to := range spec.To {
+		keys = appendUnique(keys, groupKindKey(string(to.Group), string(to.Kind)))
+	}
+	return keys, nil
+}
+
+// routeParentRefs returns the namespace and parentRefs of any route kind.
+func routeParentRefs(obj interface{}) (string, []v1beta1.ParentReference, error) {
+	switch route := obj.(type) {
+	case *v1beta1.HTTPRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1alpha2.HTTPRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1beta1.GRPCRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1alpha2.GRPCRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1alpha2.TCPRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1alpha2.TLSRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	case *v1alpha2.UDPRoute:
+		return route.Namespace, route.Spec.ParentRefs, nil
+	default:
+		return "", nil, fmt.Errorf("expected route, got %T", obj)
+	}
+}
+
+// routeBackendRefs returns the namespace and the backendRefs of every rule
+// of any route kind, including the backendRefs of RequestMirror filters.
+func routeBackendRefs(obj interface{}) (string, []v1beta1.BackendObjectReference, error) {
+	var refs []v1beta1.BackendObjectReference
+	switch route := obj.(type) {
+	case *v1beta1.HTTPRoute:
+		return route.Namespace, httpBackendRefs(route.Spec.Rules), nil
+	case *v1alpha2.HTTPRoute:
+		return route.Namespace, httpBackendRefs(route.Spec.Rules), nil
+	case *v1beta1.GRPCRoute:
+		return route.Namespace, grpcBackendRefs(route.Spec.Rules), nil
+	case *v1alpha2.GRPCRoute:
+		return route.Namespace, grpcBackendRefs(route.Spec.Rules), nil
+	case *v1alpha2.TCPRoute:
+		for _, rule := range route.Spec.Rules {
+			refs = appendBackendRefs(refs, rule.BackendRefs)
+		}
+		return route.Namespace, refs, nil
+	case *v1alpha2.TLSRoute:
+		for _, rule := range route.Spec.Rules {
+			refs = appendBackendRefs(refs, rule.BackendRefs)
+		}
+		return route.Namespace, refs, nil
+	case *v1alpha2.UDPRoute:
+		for _, rule := range route.Spec.Rules {
+			refs = appendBackendRefs(refs, rule.BackendRefs)
+		}
+		return route.Namespace, refs, nil
+	default:
+		return "", nil, fmt.Errorf("expected route, got %T", obj)
+	}
+}
+
+// httpBackendRefs returns the backendRefs of the rules, including those of
+// the RequestMirror filters on the rules and on each backendRef.
+func httpBackendRefs(rules []v1beta1.HTTPRouteRule) []v1beta1.BackendObjectReference {
+	var refs []v1beta1.BackendObjectReference
+	for _, rule := range rules {
+		refs = appendHTTPMirrorRefs(refs, rule.Filters)
+		for _, ref := range rule.BackendRefs {
+			refs = append(refs, ref.BackendObjectReference)
+			refs = appendHTTPMirrorRefs(refs, ref.Filters)
+		}
+	}
+	return refs
+}
+
+// grpcBackendRefs returns the backendRefs of the rules, including those of
+// the RequestMirror filters on the rules and on each backendRef.
+func grpcBackendRefs(rules []v1beta1.GRPCRouteRule) []v1beta1.BackendObjectReference {
+	var refs []v1beta1.BackendObjectReference
+	for _, rule := range rules {
+		refs = appendGRPCMirrorRefs(refs, rule.Filters)
+		for _, ref := range rule.BackendRefs {
+			refs = append(refs, ref.BackendObjectReference)
+			refs = appendGRPCMirrorRefs(refs, ref.Filters)
+		}
+	}
+	return refs
+}
+
+func appendHTTPMirrorRefs(refs []v1beta1.BackendObjectReference, filters []v1beta1.HTTPRouteFilter) []v1beta1.BackendObjectReference {
+	for _, filter := range filters {
+		if filter.RequestMirror != nil {
+			refs = append(refs, filter.RequestMirror.BackendRef)
+		}
+	}
+	return refs
+}
+
+func appendGRPCMirrorRefs(refs []v1beta1.BackendObjectReference, filters []v1beta1.GRPCRouteFilter) []v1beta1.BackendObjectReference {
+	for _, filter := range filters {
+		if filter.RequestMirror != nil {
+			refs = append(refs, filter.RequestMirror.BackendRef)
+		}
+	}
+	return refs
+}
+
+func appendBackendRefs(refs []v1beta1.BackendObjectReference, backendRefs []v1beta1.BackendRef) []v1beta1.BackendObjectReference {
+	for _, ref := range backendRefs {
+		refs = append(refs, ref.BackendObjectReference)
+	}
+	return refs
+}
+
+func referenceGrantSpec(obj interface{}) (*v1beta1.ReferenceGrantSpec, error) {
+	switch grant := obj.(type) {
+	case *v1beta1.ReferenceGrant:
+		return &grant.Spec, nil
+	case *v1alpha2.ReferenceGrant:
+		return &grant.Spec, nil
+	default:
+		return nil, fmt.Errorf("expected ReferenceGrant, got %T", obj)
+	}
+}
+
+// namespacedKey returns the namespace/name key of a reference, defaulting the
+// namespace to that of the referring object.
+func namespacedKey(namespace *v1beta1.Namespace, defaultNamespace, name string) string {
+	if namespace != nil {
+		defaultNamespace = string(*namespace)
+	}
+	return types.NamespacedName{Namespace: defaultNamespace, Name: name}.String()
+}
+
+func groupKindKey(group, kind string) string {
+	return schema.GroupKind{Group: group, Kind: kind}.String()
+}
+
+func appendUnique(keys []string, key string) []string {
+	for _, k := range keys {
+		if k == key {
+			return keys
+		}
+	}
+	return append(keys, key)
+}
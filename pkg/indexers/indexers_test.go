@@ -0,0 +1,460 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indexers
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+	"sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
+)
+
+func ptrTo[T any](a T) *T {
+	return &a
+}
+
+// startInformer adds indexers to the informer, starts the factory and waits
+// for the informer cache to sync.
+func startInformer(t *testing.T, factory externalversions.SharedInformerFactory, informer cache.SharedIndexInformer, indexers cache.Indexers) cache.Indexer {
+	t.Helper()
+	require.NoError(t, informer.AddIndexers(indexers))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "cache for %v did not sync", typ)
+	}
+	return informer.GetIndexer()
+}
+
+func names[T metav1.Object](objs []T) []string {
+	result := make([]string, 0, len(objs))
+	for _, obj := range objs {
+		result = append(result, obj.GetNamespace()+"/"+obj.GetName())
+	}
+	sort.Strings(result)
+	return result
+}
+
+func TestRouteIndexers(t *testing.T) {
+	objs := []runtime.Object{
+		&v1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "http-default-ns"},
+			Spec: v1beta1.HTTPRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{
+					ParentRefs: []v1beta1.ParentReference{{Name: "gw"}},
+				},
+				Rules: []v1beta1.HTTPRouteRule{{
+					BackendRefs: []v1beta1.HTTPBackendRef{{
+						BackendRef: v1beta1.BackendRef{BackendObjectReference: v1beta1.BackendObjectReference{Name: "svc"}},
+					}},
+				}},
+			},
+		},
+		&v1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "http-cross-ns"},
+			Spec: v1beta1.HTTPRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{
+					ParentRefs: []v1beta1.ParentReference{
+						{Name: "gw", Namespace: ptrTo(v1beta1.Namespace("ns1")), SectionName: ptrTo(v1beta1.SectionName("http"))},
+						{Name: "gw", Namespace: ptrTo(v1beta1.Namespace("ns1")), SectionName: ptrTo(v1beta1.SectionName("https"))},
+					},
+				},
+				Rules: []v1beta1.HTTPRouteRule{{
+					BackendRefs: []v1beta1.HTTPBackendRef{{
+						BackendRef: v1beta1.BackendRef{BackendObjectReference: v1beta1.BackendObjectReference{
+							Name:      "svc",
+							Namespace: ptrTo(v1beta1.Namespace("ns1")),
+						}},
+					}},
+				}},
+			},
+		},
+		&v1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "http-other-kinds"},
+			Spec: v1beta1.HTTPRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{
+					ParentRefs: []v1beta1.ParentReference{{
+						Name: "gw",
+						Kind: ptrTo(v1beta1.Kind("Service")),
+					}},
+				},
+				Rules: []v1beta1.HTTPRouteRule{{
+					BackendRefs: []v1beta1.HTTPBackendRef{{
+						BackendRef: v1beta1.BackendRef{BackendObjectReference: v1beta1.BackendObjectReference{
+							Name:  "svc",
+							Group: ptrTo(v1beta1.Group("multicluster.x-k8s.io")),
+							Kind:  ptrTo(v1beta1.Kind("ServiceImport")),
+						}},
+					}},
+				}},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(objs...)
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	indexer := startInformer(t, factory, factory.Gateway().V1beta1().HTTPRoutes().Informer(), RouteIndexers())
+
+	routes, err := RoutesForGateway[*v1beta1.HTTPRoute](indexer, "ns1", "gw")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns1/http-default-ns", "ns2/http-cross-ns"}, names(routes))
+
+	routes, err = RoutesForGateway[*v1beta1.HTTPRoute](indexer, "ns2", "gw")
+	require.NoError(t, err)
+	assert.Empty(t, routes)
+
+	routes, err = RoutesForService[*v1beta1.HTTPRoute](indexer, "ns1", "svc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ns1/http-default-ns", "ns2/http-cross-ns"}, names(routes))
+
+	_, err = RoutesForGateway[*v1alpha2.HTTPRoute](indexer, "ns1", "gw")
+	assert.Error(t, err, "expected an error for a mismatched object type")
+}
+
+func TestRouteIndexersAllKinds(t *testing.T) {
+	parentRefs := []v1beta1.ParentReference{{Name: "gw"}}
+	backendRef := v1beta1.BackendRef{BackendObjectReference: v1beta1.BackendObjectReference{Name: "svc"}}
+	meta := metav1.ObjectMeta{Namespace: "ns", Name: "route"}
+
+	objs := []runtime.Object{
+		&v1alpha2.HTTPRoute{
+			ObjectMeta: meta,
+			Spec: v1beta1.HTTPRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1beta1.HTTPRouteRule{{BackendRefs: []v1beta1.HTTPBackendRef{{BackendRef: backendRef}}}},
+			},
+		},
+		&v1beta1.GRPCRoute{
+			ObjectMeta: meta,
+			Spec: v1beta1.GRPCRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1beta1.GRPCRouteRule{{BackendRefs: []v1beta1.GRPCBackendRef{{BackendRef: backendRef}}}},
+			},
+		},
+		&v1alpha2.GRPCRoute{
+			ObjectMeta: meta,
+			Spec: v1beta1.GRPCRouteSpec{
+				CommonRouteSpec: v1beta1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1beta1.GRPCRouteRule{{BackendRefs: []v1beta1.GRPCBackendRef{{BackendRef: backendRef}}}},
+			},
+		},
+		&v1alpha2.TCPRoute{
+			ObjectMeta: meta,
+			Spec: v1alpha2.TCPRouteSpec{
+				CommonRouteSpec: v1alpha2.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1alpha2.TCPRouteRule{{BackendRefs: []v1alpha2.BackendRef{backendRef}}},
+			},
+		},
+		&v1alpha2.TLSRoute{
+			ObjectMeta: meta,
+			Spec: v1alpha2.TLSRouteSpec{
+				CommonRouteSpec: v1alpha2.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1alpha2.TLSRouteRule{{BackendRefs: []v1alpha2.BackendRef{backendRef}}},
+			},
+		},
+		&v1alpha2.UDPRoute{
+			ObjectMeta: meta,
+			Spec: v1alpha2.UDPRouteSpec{
+				CommonRouteSpec: v1alpha2.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []v1alpha2.UDPRouteRule{{BackendRefs: []v1alpha2.BackendRef{backendRef}}},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(objs...)
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	v1a2 := factory.Gateway().V1alpha2()
+	v1b1 := factory.Gateway().V1beta1()
+
+	tests := []struct {
+		name     string
+		informer cache.SharedIndexInformer
+	}{
+		{name: "v1alpha2 HTTPRoute", informer: v1a2.HTTPRoutes().Informer()},
+		{name: "v1beta1 GRPCRoute", informer: v1b1.GRPCRoutes().Informer()},
+		{name: "v1alpha2 GRPCRoute", informer: v1a2.GRPCRoutes().Informer()},
+		{name: "v1alpha2 TCPRoute", informer: v1a2.TCPRoutes().Informer()},
+		{name: "v1alpha2 TLSRoute", informer: v1a2.TLSRoutes().Informer()},
+		{name: "v1alpha2 UDPRoute", informer: v1a2.UDPRoutes().Informer()},
+	}
+	for _, tc := range tests {
+		require.NoError(t, tc.informer.AddIndexers(RouteIndexers()), tc.name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "cache for %v did not sync", typ)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := tc.informer.GetIndexer()
+
+			routes, err := RoutesForGateway[metav1.Object](indexer, "ns", "gw")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"ns/route"}, names(routes))
+
+			routes, err = RoutesForService[metav1.Object](indexer, "ns", "svc")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"ns/route"}, names(routes))
+		})
+	}
+}
+
+func TestRouteIndexersRequestMirror(t *testing.T) {
+	ruleMirror := v1beta1.HTTPRequestMirrorFilter{BackendRef: v1beta1.BackendObjectReference{Name: "rule-mirror"}}
+	backendMirror := v1beta1.HTTPRequestMirrorFilter{BackendRef: v1beta1.BackendObjectReference{
+		Name:      "backend-mirror",
+		Namespace: ptrTo(v1beta1.Namespace("ns2")),
+	}}
+	backendRef := v1beta1.BackendRef{BackendObjectReference: v1beta1.BackendObjectReference{Name: "svc"}}
+	meta := metav1.ObjectMeta{Namespace: "ns", Name: "route"}
+
+	objs := []runtime.Object{
+		&v1beta1.HTTPRoute{
+			ObjectMeta: meta,
+			Spec: v1beta1.HTTPRouteSpec{
+				Rules: []v1beta1.HTTPRouteRule{{
+					Filters: []v1beta1.HTTPRouteFilter{{Type: v1beta1.HTTPRouteFilterRequestMirror, RequestMirror: &ruleMirror}},
+					BackendRefs: []v1beta1.HTTPBackendRef{{
+						BackendRef: backendRef,
+						Filters:    []v1beta1.HTTPRouteFilter{{Type: v1beta1.HTTPRouteFilterRequestMirror, RequestMirror: &backendMirror}},
+					}},
+				}},
+			},
+		},
+		&v1beta1.GRPCRoute{
+			ObjectMeta: meta,
+			Spec: v1beta1.GRPCRouteSpec{
+				Rules: []v1beta1.GRPCRouteRule{{
+					Filters: []v1beta1.GRPCRouteFilter{{Type: v1beta1.GRPCRouteFilterRequestMirror, RequestMirror: &ruleMirror}},
+					BackendRefs: []v1beta1.GRPCBackendRef{{
+						BackendRef: backendRef,
+						Filters:    []v1beta1.GRPCRouteFilter{{Type: v1beta1.GRPCRouteFilterRequestMirror, RequestMirror: &backendMirror}},
+					}},
+				}},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(objs...)
+	factory := externalversions.NewSharedInformerFactory(client, 0)
+	v1b1 := factory.Gateway().V1beta1()
+
+	tests := []struct {
+		name     string
+		informer cache.SharedIndexInformer
+	}{
+		{name: "v1beta1 HTTPRoute", informer: v1b1.HTTPRoutes().Informer()},
+		{name: "v1beta1 GRPCRoute", informer: v1b1.GRPCRoutes().Informer()},
+	}
+	for _, tc := range tests {
+		require.NoError(t, tc.informer.AddIndexers(RouteIndexers()), tc.name)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx.Done())
+	for typ, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "cache for %v did not sync", typ)
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := tc.informer.GetIndexer()
+
+			for _, svc := range []types.NamespacedName{
+				{Namespace: "ns", Name: "svc"},
+				{Namespace: "ns", Name: "rule-mirror"},
+				{Namespace: "ns2", Name: "backend-mirror"},
+			} {
+				routes, err := RoutesForService[metav1.Object](indexer, svc.Namespace, svc.Name)
+				require.NoError(t, err)
+				assert.Equal(t, []string{"ns/route"}, names(routes), svc.String())
+			}
+
+			routes, err := RoutesForService[metav1.Object](indexer, "ns", "backend-mirror")
+			require.NoError(t, err)
+			assert.Empty(t, routes)
+		})
+	}
+}
+
+func TestGatewayIndexers(t *testing.T) {
+	tls := func(refs ...v1beta1.SecretObjectReference) *v1beta1.GatewayTLSConfig {
+		return &v1beta1.GatewayTLSConfig{CertificateRefs: refs}
+	}
+	gateways := []*v1beta1.Gateway{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "gw1"},
+			Spec: v1beta1.GatewaySpec{
+				GatewayClassName: "class-a",
+				Listeners: []v1beta1.Listener{
+					{Name: "http", Protocol: v1beta1.HTTPProtocolType, Port: 80},
+					{Name: "https", Protocol: v1beta1.HTTPSProtocolType, Port: 443, TLS: tls(v1beta1.SecretObjectReference{Name: "cert"})},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "gw2"},
+			Spec: v1beta1.GatewaySpec{
+				GatewayClassName: "class-b",
+				Listeners: []v1beta1.Listener{
+					{Name: "https", Protocol: v1beta1.HTTPSProtocolType, Port: 443, TLS: tls(
+						v1beta1.SecretObjectReference{Name: "cert", Namespace: ptrTo(v1beta1.Namespace("ns1"))},
+						v1beta1.SecretObjectReference{Name: "other", Kind: ptrTo(v1beta1.Kind("ConfigMap"))},
+					)},
+				},
+			},
+		},
+	}
+	gatewayV1alpha2 := &v1alpha2.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "gw-v1alpha2"},
+		Spec: v1beta1.GatewaySpec{
+			GatewayClassName: "class-a",
+			Listeners:        []v1beta1.Listener{{Name: "http", Protocol: v1beta1.HTTPProtocolType, Port: 80}},
+		},
+	}
+
+	// Gateways are created through the typed client rather than passed to
+	// NewSimpleClientset: the fake object tracker guesses the resource
+	// "gatewaies" from the Gateway kind, which the typed client never reads.
+	client := fake.NewSimpleClientset()
+	ctx := context.Background()
+	for _, gw := range gateways {
+		_, err := client.GatewayV1beta1().Gateways(gw.Namespace).Create(ctx, gw, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	_, err := client.GatewayV1alpha2().Gateways(gatewayV1alpha2.Namespace).Create(ctx, gatewayV1alpha2, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	t.Run("v1beta1", func(t *testing.T) {
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		indexer := startInformer(t, factory, factory.Gateway().V1beta1().Gateways().Informer(), GatewayIndexers())
+
+		gateways, err := GatewaysForGatewayClass[*v1beta1.Gateway](indexer, "class-a")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1/gw1"}, names(gateways))
+
+		gateways, err = GatewaysForCertificateSecret[*v1beta1.Gateway](indexer, "ns1", "cert")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1/gw1", "ns2/gw2"}, names(gateways))
+
+		gateways, err = GatewaysForCertificateSecret[*v1beta1.Gateway](indexer, "ns2", "other")
+		require.NoError(t, err)
+		assert.Empty(t, gateways)
+	})
+
+	t.Run("v1alpha2", func(t *testing.T) {
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		indexer := startInformer(t, factory, factory.Gateway().V1alpha2().Gateways().Informer(), GatewayIndexers())
+
+		gateways, err := GatewaysForGatewayClass[*v1alpha2.Gateway](indexer, "class-a")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ns1/gw-v1alpha2"}, names(gateways))
+	})
+}
+
+func TestReferenceGrantIndexers(t *testing.T) {
+	objs := []runtime.Object{
+		&v1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "backends", Name: "allow-routes"},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{
+					{Group: v1beta1.GroupName, Kind: "HTTPRoute", Namespace: "frontend"},
+					{Group: v1beta1.GroupName, Kind: "GRPCRoute", Namespace: "frontend"},
+				},
+				To: []v1beta1.ReferenceGrantTo{{Group: "", Kind: "Service"}},
+			},
+		},
+		&v1beta1.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "certs", Name: "allow-gateways"},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{{Group: v1beta1.GroupName, Kind: "Gateway", Namespace: "infra"}},
+				To:   []v1beta1.ReferenceGrantTo{{Group: "", Kind: "Secret"}},
+			},
+		},
+		&v1alpha2.ReferenceGrant{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "backends", Name: "allow-imports"},
+			Spec: v1beta1.ReferenceGrantSpec{
+				From: []v1beta1.ReferenceGrantFrom{{Group: v1beta1.GroupName, Kind: "HTTPRoute", Namespace: "frontend"}},
+				To:   []v1beta1.ReferenceGrantTo{{Group: "multicluster.x-k8s.io", Kind: "ServiceImport"}},
+			},
+		},
+	}
+
+	client := fake.NewSimpleClientset(objs...)
+
+	t.Run("v1beta1", func(t *testing.T) {
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		indexer := startInformer(t, factory, factory.Gateway().V1beta1().ReferenceGrants().Informer(), ReferenceGrantIndexers())
+
+		grants, err := ReferenceGrantsFromNamespace[*v1beta1.ReferenceGrant](indexer, "frontend")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"backends/allow-routes"}, names(grants))
+
+		grants, err = ReferenceGrantsToKind[*v1beta1.ReferenceGrant](indexer, "", "Secret")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"certs/allow-gateways"}, names(grants))
+	})
+
+	t.Run("v1alpha2", func(t *testing.T) {
+		factory := externalversions.NewSharedInformerFactory(client, 0)
+		indexer := startInformer(t, factory, factory.Gateway().V1alpha2().ReferenceGrants().Informer(), ReferenceGrantIndexers())
+
+		grants, err := ReferenceGrantsToKind[*v1alpha2.ReferenceGrant](indexer, "multicluster.x-k8s.io", "ServiceImport")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"backends/allow-imports"}, names(grants))
+
+		grants, err = ReferenceGrantsFromNamespace[*v1alpha2.ReferenceGrant](indexer, "infra")
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+}
+
+func TestIndexFuncsRejectUnexpectedTypes(t *testing.T) {
+	gateway := &v1beta1.Gateway{}
+	route := &v1beta1.HTTPRoute{}
+
+	for name, fn := range RouteIndexers() {
+		_, err := fn(gateway)
+		assert.Error(t, err, name)
+	}
+	for name, fn := range GatewayIndexers() {
+		_, err := fn(route)
+		assert.Error(t, err, name)
+	}
+	for name, fn := range ReferenceGrantIndexers() {
+		_, err := fn(route)
+		assert.Error(t, err, name)
+	}
+}
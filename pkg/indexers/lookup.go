@@ -0,0 +1,88 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package indexers
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+// The lookup helpers below are parameterized by the object type stored in
+// the indexer, for example:
+//
+//	routes, err := indexers.RoutesForGateway[*v1beta1.HTTPRoute](
+//		factory.Gateway().V1beta1().HTTPRoutes().Informer().GetIndexer(), "default", "my-gateway")
+//
+// The indexer must have been created with the matching indexers, e.g. via
+// Informer().AddIndexers(indexers.RouteIndexers()) before the informer is
+// started.
+
+// RoutesForGateway returns the routes that reference the Gateway with the
+// given namespace and name as a parent.
+func RoutesForGateway[T any](indexer cache.Indexer, namespace, name string) ([]T, error) {
+	return byIndex[T](indexer, ParentGatewayIndex, types.NamespacedName{Namespace: namespace, Name: name}.String())
+}
+
+// RoutesForService returns the routes that reference the Service with the
+// given namespace and name as a backend.
+func RoutesForService[T any](indexer cache.Indexer, namespace, name string) ([]T, error) {
+	return byIndex[T](indexer, BackendServiceIndex, types.NamespacedName{Namespace: namespace, Name: name}.String())
+}
+
+// GatewaysForGatewayClass returns the Gateways that use the named
+// GatewayClass.
+func GatewaysForGatewayClass[T any](indexer cache.Indexer, gatewayClassName string) ([]T, error) {
+	return byIndex[T](indexer, GatewayClassIndex, gatewayClassName)
+}
+
+// GatewaysForCertificateSecret returns the Gateways that have a listener
+// referencing the Secret with the given namespace and name as a TLS
+// certificate.
+func GatewaysForCertificateSecret[T any](indexer cache.Indexer, namespace, name string) ([]T, error) {
+	return byIndex[T](indexer, CertificateSecretIndex, types.NamespacedName{Namespace: namespace, Name: name}.String())
+}
+
+// ReferenceGrantsFromNamespace returns the ReferenceGrants that allow
+// references from the given namespace.
+func ReferenceGrantsFromNamespace[T any](indexer cache.Indexer, namespace string) ([]T, error) {
+	return byIndex[T](indexer, ReferenceGrantFromNamespaceIndex, namespace)
+}
+
+// ReferenceGrantsToKind returns the ReferenceGrants that allow references to
+// objects of the given group and kind. Use "" for the core group.
+func ReferenceGrantsToKind[T any](indexer cache.Indexer, group, kind string) ([]T, error) {
+	return byIndex[T](indexer, ReferenceGrantToKindIndex, groupKindKey(group, kind))
+}
+
+func byIndex[T any](indexer cache.Indexer, index, key string) ([]T, error) {
+	objs, err := indexer.ByIndex(index, key)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]T, 0, len(objs))
+	for _, obj := range objs {
+		t, ok := obj.(T)
+		if !ok {
+			return nil, fmt.Errorf("index %s: expected %T, got %T", index, *new(T), obj)
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiversion
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Interface reads and writes a single kind through the newest served
+// version. T is the internal model of the kind and L the list of it.
+type Interface[T, L any] interface {
+	Create(ctx context.Context, obj *T, opts metav1.CreateOptions) (*T, error)
+	Update(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*T, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*L, error)
+}
+
+// StatusInterface is an Interface for a kind with a status subresource.
+type StatusInterface[T, L any] interface {
+	Interface[T, L]
+	UpdateStatus(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error)
+}
+
+// adapter implements Interface on top of a generated typed client for the
+// served version V, converting between V and the internal model T.
+type adapter[T, L, V, VL any] struct {
+	client   Interface[V, VL]
+	toModel  func(*V) *T
+	toServed func(*T) *V
+	toList   func(*VL) *L
+}
+
+func (a *adapter[T, L, V, VL]) Create(ctx context.Context, obj *T, opts metav1.CreateOptions) (*T, error) {
+	return a.convert(a.client.Create(ctx, a.toServed(obj), opts))
+}
+
+func (a *adapter[T, L, V, VL]) Update(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error) {
+	return a.convert(a.client.Update(ctx, a.toServed(obj), opts))
+}
+
+func (a *adapter[T, L, V, VL]) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return a.client.Delete(ctx, name, opts)
+}
+
+func (a *adapter[T, L, V, VL]) Get(ctx context.Context, name string, opts metav1.GetOptions) (*T, error) {
+	return a.convert(a.client.Get(ctx, name, opts))
+}
+
+func (a *adapter[T, L, V, VL]) List(ctx context.Context, opts metav1.ListOptions) (*L, error) {
+	list, err := a.client.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return a.toList(list), nil
+}
+
+func (a *adapter[T, L, V, VL]) convert(obj *V, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return a.toModel(obj), nil
+}
+
+// statusAdapter is an adapter for a kind with a status subresource.
+type statusAdapter[T, L, V, VL any] struct {
+	adapter[T, L, V, VL]
+	status StatusInterface[V, VL]
+}
+
+func newStatusAdapter[T, L, V, VL any](client StatusInterface[V, VL], toModel func(*V) *T, toServed func(*T) *V, toList func(*VL) *L) *statusAdapter[T, L, V, VL] {
+	return &statusAdapter[T, L, V, VL]{
+		adapter: adapter[T, L, V, VL]{
+			client:   client,
+			toModel:  toModel,
+			toServed: toServed,
+			toList:   toList,
+		},
+		status: client,
+	}
+}
+
+func (a *statusAdapter[T, L, V, VL]) UpdateStatus(ctx context.Context, obj *T, opts metav1.UpdateOptions) (*T, error) {
+	return a.convert(a.status.UpdateStatus(ctx, a.toServed(obj), opts))
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package multiversion provides a client over the generated clientset that
+// discovers which Gateway API versions and kinds a cluster serves, and reads
+// and writes every kind through the newest served version.
+//
+// Callers work with a single internal model per kind: the v1beta1 types for
+// kinds that have graduated to v1beta1, and the v1alpha2 types for the
+// kinds that are only available in v1alpha2.
+package multiversion
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+)
+
+// versions lists the Gateway API versions understood by this client, newest
+// first.
+var versions = []string{
+	v1beta1.SchemeGroupVersion.Version,
+	v1alpha2.SchemeGroupVersion.Version,
+}
+
+// The interfaces below expose each kind through its internal model.
+type (
+	GatewayClassInterface   = StatusInterface[v1beta1.GatewayClass, v1beta1.GatewayClassList]
+	GatewayInterface        = StatusInterface[v1beta1.Gateway, v1beta1.GatewayList]
+	HTTPRouteInterface      = StatusInterface[v1beta1.HTTPRoute, v1beta1.HTTPRouteList]
+	GRPCRouteInterface      = StatusInterface[v1beta1.GRPCRoute, v1beta1.GRPCRouteList]
+	ReferenceGrantInterface = Interface[v1beta1.ReferenceGrant, v1beta1.ReferenceGrantList]
+	TCPRouteInterface       = StatusInterface[v1alpha2.TCPRoute, v1alpha2.TCPRouteList]
+	TLSRouteInterface       = StatusInterface[v1alpha2.TLSRoute, v1alpha2.TLSRouteList]
+	UDPRouteInterface       = StatusInterface[v1alpha2.UDPRoute, v1alpha2.UDPRouteList]
+)
+
+// NotServedError is returned when a kind is not served by any Gateway API
+// version known to this client.
+type NotServedError struct {
+	Kind string
+}
+
+func (e *NotServedError) Error() string {
+	return fmt.Sprintf("%s.%s is not served in any of the versions %s", e.Kind, v1beta1.GroupName, strings.Join(versions, ", "))
+}
+
+// IsNotServed returns true if err is or wraps a NotServedError.
+func IsNotServed(err error) bool {
+	var notServed *NotServedError
+	return errors.As(err, &notServed)
+}
+
+// Client reads and writes Gateway API resources through the newest version
+// served for each kind.
+type Client struct {
+	clientset versioned.Interface
+
+	// served maps each served kind to the newest version serving it.
+	served map[string]string
+}
+
+// New returns a Client for clientset, using its discovery client to learn
+// which versions and kinds are served. The result of discovery is not
+// refreshed; create a new Client after installing or upgrading CRDs.
+func New(clientset versioned.Interface) (*Client, error) {
+	served := map[string]string{}
+	for _, version := range versions {
+		groupVersion := v1beta1.GroupName + "/" + version
+		resources, err := clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("discovering %s: %w", groupVersion, err)
+		}
+		for _, resource := range resources.APIResources {
+			// Skip subresources such as httproutes/status.
+			if strings.Contains(resource.Name, "/") {
+				continue
+			}
+			if _, ok := served[resource.Kind]; !ok {
+				served[resource.Kind] = version
+			}
+		}
+	}
+	return &Client{clientset: clientset, served: served}, nil
+}
+
+// ServedVersion returns the version that the Client uses for kind.
+func (c *Client) ServedVersion(kind string) (string, error) {
+	version, ok := c.served[kind]
+	if !ok {
+		return "", &NotServedError{Kind: kind}
+	}
+	return version, nil
+}
+
+// GatewayClasses returns a client for GatewayClasses.
+func (c *Client) GatewayClasses() (GatewayClassInterface, error) {
+	switch c.served["GatewayClass"] {
+	case v1beta1.SchemeGroupVersion.Version:
+		return c.clientset.GatewayV1beta1().GatewayClasses(), nil
+	case v1alpha2.SchemeGroupVersion.Version:
+		return newStatusAdapter[v1beta1.GatewayClass, v1beta1.GatewayClassList, v1alpha2.GatewayClass, v1alpha2.GatewayClassList](
+			c.clientset.GatewayV1alpha2().GatewayClasses(),
+			func(gc *v1alpha2.GatewayClass) *v1beta1.GatewayClass { return (*v1beta1.GatewayClass)(gc) },
+			func(gc *v1beta1.GatewayClass) *v1alpha2.GatewayClass { return (*v1alpha2.GatewayClass)(gc) },
+			func(list *v1alpha2.GatewayClassList) *v1beta1.GatewayClassList {
+				return &v1beta1.GatewayClassList{ListMeta: list.ListMeta, Items: convertItems(list.Items, func(gc v1alpha2.GatewayClass) v1beta1.GatewayClass { return v1beta1.GatewayClass(gc) })}
+			},
+		), nil
+	default:
+		return nil, &NotServedError{Kind: "GatewayClass"}
+	}
+}
+
+// Gateways returns a client for Gateways in namespace.
+func (c *Client) Gateways(namespace string) (GatewayInterface, error) {
+	switch c.served["Gateway"] {
+	case v1beta1.SchemeGroupVersion.Version:
+		return c.clientset.GatewayV1beta1().Gateways(namespace), nil
+	case v1alpha2.SchemeGroupVersion.Version:
+		return newStatusAdapter[v1beta1.Gateway, v1beta1.GatewayList, v1alpha2.Gateway, v1alpha2.GatewayList](
+			c.clientset.GatewayV1alpha2().Gateways(namespace),
+			func(gw *v1alpha2.Gateway) *v1beta1.Gateway { return (*v1beta1.Gateway)(gw) },
+			func(gw *v1beta1.Gateway) *v1alpha2.Gateway { return (*v1alpha2.Gateway)(gw) },
+			func(list *v1alpha2.GatewayList) *v1beta1.GatewayList {
+				return &v1beta1.GatewayList{ListMeta: list.ListMeta, Items: convertItems(list.Items, func(gw v1alpha2.Gateway) v1beta1.Gateway { return v1beta1.Gateway(gw) })}
+			},
+		), nil
+	default:
+		return nil, &NotServedError{Kind: "Gateway"}
+	}
+}
+
+// HTTPRoutes returns a client for HTTPRoutes in namespace.
+func (c *Client) HTTPRoutes(namespace string) (HTTPRouteInterface, error) {
+	switch c.served["HTTPRoute"] {
+	case v1beta1.SchemeGroupVersion.Version:
+		return c.clientset.GatewayV1beta1().HTTPRoutes(namespace), nil
+	case v1alpha2.SchemeGroupVersion.Version:
+		return newStatusAdapter[v1beta1.HTTPRoute, v1beta1.HTTPRouteList, v1alpha2.HTTPRoute, v1alpha2.HTTPRouteList](
+			c.clientset.GatewayV1alpha2().HTTPRoutes(namespace),
+			func(route *v1alpha2.HTTPRoute) *v1beta1.HTTPRoute { return (*v1beta1.HTTPRoute)(route) },
+			func(route *v1beta1.HTTPRoute) *v1alpha2.HTTPRoute { return (*v1alpha2.HTTPRoute)(route) },
+			func(list *v1alpha2.HTTPRouteList) *v1beta1.HTTPRouteList {
+				return &v1beta1.HTTPRouteList{ListMeta: list.ListMeta, Items: convertItems(list.Items, func(route v1alpha2.HTTPRoute) v1beta1.HTTPRoute { return v1beta1.HTTPRoute(route) })}
+			},
+		), nil
+	default:
+		return nil, &NotServedError{Kind: "HTTPRoute"}
+	}
+}
+
+// GRPCRoutes returns a client for GRPCRoutes in namespace.
+func (c *Client) GRPCRoutes(namespace string) (GRPCRouteInterface, error) {
+	switch c.served["GRPCRoute"] {
+	case v1beta1.SchemeGroupVersion.Version:
+		return c.clientset.GatewayV1beta1().GRPCRoutes(namespace), nil
+	case v1alpha2.SchemeGroupVersion.Version:
+		return newStatusAdapter[v1beta1.GRPCRoute, v1beta1.GRPCRouteList, v1alpha2.GRPCRoute, v1alpha2.GRPCRouteList](
+			c.clientset.GatewayV1alpha2().GRPCRoutes(namespace),
+			func(route *v1alpha2.GRPCRoute) *v1beta1.GRPCRoute { return (*v1beta1.GRPCRoute)(route) },
+			func(route *v1beta1.GRPCRoute) *v1alpha2.GRPCRoute { return (*v1alpha2.GRPCRoute)(route) },
+			func(list *v1alpha2.GRPCRouteList) *v1beta1.GRPCRouteList {
+				return &v1beta1.GRPCRouteList{ListMeta: list.ListMeta, Items: convertItems(list.Items, func(route v1alpha2.GRPCRoute) v1beta1.GRPCRoute { return v1beta1.GRPCRoute(route) })}
+			},
+		), nil
+	default:
+		return nil, &NotServedError{Kind: "GRPCRoute"}
+	}
+}
+
+// ReferenceGrants returns a client for ReferenceGrants in namespace.
+func (c *Client) ReferenceGrants(namespace string) (ReferenceGrantInterface, error) {
+	switch c.served["ReferenceGrant"] {
+	case v1beta1.SchemeGroupVersion.Version:
+		return c.clientset.GatewayV1beta1().ReferenceGrants(namespace), nil
+	case v1alpha2.SchemeGroupVersion.Version:
+		return &adapter[v1beta1.ReferenceGrant, v1beta1.ReferenceGrantList, v1alpha2.ReferenceGrant, v1alpha2.ReferenceGrantList]{
+			client:   c.clientset.GatewayV1alpha2().ReferenceGrants(namespace),
+			toModel:  func(grant *v1alpha2.ReferenceGrant) *v1beta1.ReferenceGrant { return (*v1beta1.ReferenceGrant)(grant) },
+			toServed: func(grant *v1beta1.ReferenceGrant) *v1alpha2.ReferenceGrant { return (*v1alpha2.ReferenceGrant)(grant) },
+			toList: func(list *v1alpha2.ReferenceGrantList) *v1beta1.ReferenceGrantList {
+				return &v1beta1.ReferenceGrantList{ListMeta: list.ListMeta, Items: convertItems(list.Items, func(grant v1alpha2.ReferenceGrant) v1beta1.ReferenceGrant { return v1beta1.ReferenceGrant(grant) })}
+			},
+		}, nil
+	default:
+		return nil, &NotServedError{Kind: "ReferenceGrant"}
+	}
+}
+
+// TCPRoutes returns a client for TCPRoutes in namespace.
+func (c *Client) TCPRoutes(namespace string) (TCPRouteInterface, error) {
+	if c.served["TCPRoute"] != v1alpha2.SchemeGroupVersion.Version {
+		return nil, &NotServedError{Kind: "TCPRoute"}
+	}
+	return c.clientset.GatewayV1alpha2().TCPRoutes(namespace), nil
+}
+
+// TLSRoutes returns a client for TLSRoutes in namespace.
+func (c *Client) TLSRoutes(namespace string) (TLSRouteInterface, error) {
+	if c.served["TLSRoute"] != v1alpha2.SchemeGroupVersion.Version {
+		return nil, &NotServedError{Kind: "TLSRoute"}
+	}
+	return c.clientset.GatewayV1alpha2().TLSRoutes(namespace), nil
+}
+
+// UDPRoutes returns a client for UDPRoutes in namespace.
+func (c *Client) UDPRoutes(namespace string) (UDPRouteInterface, error) {
+	if c.served["UDPRoute"] != v1alpha2.SchemeGroupVersion.Version {
+		return nil, &NotServedError{Kind: "UDPRoute"}
+	}
+	return c.clientset.GatewayV1alpha2().UDPRoutes(namespace), nil
+}
+
+func convertItems[V, T any](items []V, convert func(V) T) []T {
+	if items == nil {
+		return nil
+	}
+	result := make([]T, len(items))
+	for i := range items {
+		result[i] = convert(items[i])
+	}
+	return result
+}
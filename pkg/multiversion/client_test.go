@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiversion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+)
+
+// newFakeClientset returns a fake clientset whose discovery serves the given
+// kinds for each version.
+func newFakeClientset(served map[string][]string) *fake.Clientset {
+	clientset := fake.NewSimpleClientset()
+	for version, kinds := range served {
+		list := &metav1.APIResourceList{GroupVersion: v1beta1.GroupName + "/" + version}
+		for _, kind := range kinds {
+			list.APIResources = append(list.APIResources,
+				metav1.APIResource{Name: resourceName(kind), Kind: kind, Namespaced: kind != "GatewayClass"},
+				metav1.APIResource{Name: resourceName(kind) + "/status", Kind: kind, Namespaced: kind != "GatewayClass"},
+			)
+		}
+		clientset.Resources = append(clientset.Resources, list)
+	}
+	return clientset
+}
+
+func resourceName(kind string) string {
+	switch kind {
+	case "GatewayClass":
+		return "gatewayclasses"
+	case "Gateway":
+		return "gateways"
+	default:
+		return fmt.Sprintf("%ss", kind)
+	}
+}
+
+func TestServedVersion(t *testing.T) {
+	clientset := newFakeClientset(map[string][]string{
+		"v1beta1":  {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant"},
+		"v1alpha2": {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant", "GRPCRoute", "TCPRoute"},
+	})
+	c, err := New(clientset)
+	require.NoError(t, err)
+
+	tests := []struct {
+		kind    string
+		version string
+	}{
+		{kind: "GatewayClass", version: "v1beta1"},
+		{kind: "Gateway", version: "v1beta1"},
+		{kind: "HTTPRoute", version: "v1beta1"},
+		{kind: "ReferenceGrant", version: "v1beta1"},
+		{kind: "GRPCRoute", version: "v1alpha2"},
+		{kind: "TCPRoute", version: "v1alpha2"},
+	}
+	for _, tc := range tests {
+		version, err := c.ServedVersion(tc.kind)
+		require.NoError(t, err, tc.kind)
+		assert.Equal(t, tc.version, version, tc.kind)
+	}
+
+	_, err = c.ServedVersion("TLSRoute")
+	assert.True(t, IsNotServed(err), "expected NotServedError, got %v", err)
+}
+
+func TestNotServed(t *testing.T) {
+	// The standard channel without any experimental kinds.
+	clientset := newFakeClientset(map[string][]string{
+		"v1beta1": {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant"},
+	})
+	c, err := New(clientset)
+	require.NoError(t, err)
+
+	_, err = c.TLSRoutes("default")
+	require.Error(t, err)
+	assert.True(t, IsNotServed(err))
+	assert.Equal(t, &NotServedError{Kind: "TLSRoute"}, err)
+
+	_, err = c.GRPCRoutes("default")
+	assert.True(t, IsNotServed(err))
+	_, err = c.TCPRoutes("default")
+	assert.True(t, IsNotServed(err))
+	_, err = c.UDPRoutes("default")
+	assert.True(t, IsNotServed(err))
+
+	_, err = c.HTTPRoutes("default")
+	assert.NoError(t, err)
+	assert.False(t, IsNotServed(fmt.Errorf("wrapped: %w", apierrors.NewNotFound(v1beta1.Resource("httproutes"), "foo"))))
+	assert.True(t, IsNotServed(fmt.Errorf("wrapped: %w", &NotServedError{Kind: "TLSRoute"})))
+}
+
+func TestHTTPRoutesV1alpha2Only(t *testing.T) {
+	ctx := context.Background()
+	clientset := newFakeClientset(map[string][]string{
+		"v1alpha2": {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant"},
+	})
+	c, err := New(clientset)
+	require.NoError(t, err)
+
+	routes, err := c.HTTPRoutes("default")
+	require.NoError(t, err)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "route"},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{{Name: "gw"}},
+			},
+		},
+	}
+	created, err := routes.Create(ctx, route, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, route.Spec, created.Spec)
+
+	// The route was written through v1alpha2 only.
+	stored, err := clientset.GatewayV1alpha2().HTTPRoutes("default").Get(ctx, "route", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, route.Spec, stored.Spec)
+	_, err = clientset.GatewayV1beta1().HTTPRoutes("default").Get(ctx, "route", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected NotFound, got %v", err)
+
+	created.Status.Parents = []v1beta1.RouteParentStatus{{
+		ParentRef:      v1beta1.ParentReference{Name: "gw"},
+		ControllerName: "example.com/controller",
+	}}
+	updated, err := routes.UpdateStatus(ctx, created, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, created.Status, updated.Status)
+
+	list, err := routes.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, updated.Status, list.Items[0].Status)
+
+	got, err := routes.Get(ctx, "route", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, updated.Status, got.Status)
+
+	require.NoError(t, routes.Delete(ctx, "route", metav1.DeleteOptions{}))
+	_, err = routes.Get(ctx, "route", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected NotFound, got %v", err)
+}
+
+func TestNewestVersionPreferred(t *testing.T) {
+	ctx := context.Background()
+	clientset := newFakeClientset(map[string][]string{
+		"v1beta1":  {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant"},
+		"v1alpha2": {"GatewayClass", "Gateway", "HTTPRoute", "ReferenceGrant"},
+	})
+	c, err := New(clientset)
+	require.NoError(t, err)
+
+	gateways, err := c.Gateways("default")
+	require.NoError(t, err)
+	_, err = gateways.Create(ctx, &v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "gw"},
+		Spec:       v1beta1.GatewaySpec{GatewayClassName: "example"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	_, err = clientset.GatewayV1beta1().Gateways("default").Get(ctx, "gw", metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = clientset.GatewayV1alpha2().Gateways("default").Get(ctx, "gw", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err), "expected NotFound, got %v", err)
+}
+
+func TestV1alpha2Adapters(t *testing.T) {
+	ctx := context.Background()
+	clientset := newFakeClientset(map[string][]string{
+		"v1alpha2": {"GatewayClass", "Gateway", "GRPCRoute", "ReferenceGrant"},
+	})
+	c, err := New(clientset)
+	require.NoError(t, err)
+
+	gatewayClasses, err := c.GatewayClasses()
+	require.NoError(t, err)
+	_, err = gatewayClasses.Create(ctx, &v1beta1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec:       v1beta1.GatewayClassSpec{ControllerName: "example.com/controller"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = clientset.GatewayV1alpha2().GatewayClasses().Get(ctx, "example", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	grpcRoutes, err := c.GRPCRoutes("default")
+	require.NoError(t, err)
+	_, err = grpcRoutes.Create(ctx, &v1beta1.GRPCRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "route"}}, metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = clientset.GatewayV1alpha2().GRPCRoutes("default").Get(ctx, "route", metav1.GetOptions{})
+	assert.NoError(t, err)
+
+	grants, err := c.ReferenceGrants("default")
+	require.NoError(t, err)
+	grant := &v1beta1.ReferenceGrant{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "grant"},
+		Spec: v1beta1.ReferenceGrantSpec{
+			From: []v1beta1.ReferenceGrantFrom{{Group: v1beta1.GroupName, Kind: "HTTPRoute", Namespace: "other"}},
+			To:   []v1beta1.ReferenceGrantTo{{Kind: "Service"}},
+		},
+	}
+	_, err = grants.Create(ctx, grant, metav1.CreateOptions{})
+	require.NoError(t, err)
+	grant.Spec.To[0].Kind = "Secret"
+	updated, err := grants.Update(ctx, grant, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1beta1.Kind("Secret"), updated.Spec.To[0].Kind)
+
+	stored, err := clientset.GatewayV1alpha2().ReferenceGrants("default").Get(ctx, "grant", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha2.Kind("Secret"), stored.Spec.To[0].Kind)
+}
@@ -0,0 +1,182 @@
+//go:build experimental
+// +build experimental
+
+/*
+Copyright 2023 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	gatewayv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGatewayInfrastructureExperimental(t *testing.T) {
+	tests := []struct {
+		name           string
+		wantErrors     []string
+		infrastructure *gatewayv1b1.GatewayInfrastructure
+	}{
+		{
+			name:       "valid labels and annotations",
+			wantErrors: []string{},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/team": "networking",
+					"tier":             "edge",
+				},
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/owner": "Networking team <networking@example.com>",
+				},
+				ParametersRef: &gatewayv1b1.LocalParametersReference{
+					Group: "example.com",
+					Kind:  "GatewayConfig",
+					Name:  "foo",
+				},
+			},
+		},
+		{
+			name:       "invalid because label key uses reserved prefix",
+			wantErrors: []string{"Label keys must not use the reserved gateway.networking.k8s.io/ prefix"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"gateway.networking.k8s.io/gateway-name": "foo",
+				},
+			},
+		},
+		{
+			name:       "invalid because annotation key uses reserved prefix",
+			wantErrors: []string{"Annotation keys must not use the reserved gateway.networking.k8s.io/ prefix"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"gateway.networking.k8s.io/foo": "bar",
+				},
+			},
+		},
+		{
+			name:       "invalid because label name segment is too long",
+			wantErrors: []string{"Label keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					gatewayv1b1.AnnotationKey("example.com/" + strings.Repeat("a", 64)): "foo",
+				},
+			},
+		},
+		{
+			name:       "invalid because label value is too long",
+			wantErrors: []string{"Label values must be 63 characters or less"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/team": gatewayv1b1.AnnotationValue(strings.Repeat("a", 64)),
+				},
+			},
+		},
+		{
+			name:       "invalid because label value contains a space",
+			wantErrors: []string{"Label values must be 63 characters or less"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/team": "networking team",
+				},
+			},
+		},
+		{
+			name:       "invalid because label key is too long",
+			wantErrors: []string{"Label keys must be 253 characters or less"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					gatewayv1b1.AnnotationKey(strings.Repeat("a", 190) + ".com/" + strings.Repeat("a", 60)): "foo",
+				},
+			},
+		},
+		{
+			name:       "invalid because annotation key is too long",
+			wantErrors: []string{"Annotation keys must be 253 characters or less"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					gatewayv1b1.AnnotationKey(strings.Repeat("a", 190) + ".com/" + strings.Repeat("a", 60)): "foo",
+				},
+			},
+		},
+		{
+			name:       "invalid because annotation key contains a space",
+			wantErrors: []string{"Annotation keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/owner team": "networking",
+				},
+			},
+		},
+		{
+			name:       "invalid because annotation key has an uppercase prefix",
+			wantErrors: []string{"Annotation keys must be in the form of an optional DNS subdomain prefix followed by a required name segment of up to 63 characters"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"Example.com/owner": "networking",
+				},
+			},
+		},
+		{
+			name:       "invalid because annotation value is too long",
+			wantErrors: []string{"spec.infrastructure.annotations[example.com/owner]: Too long: may not be longer than 4096"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Annotations: map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{
+					"example.com/owner": gatewayv1b1.AnnotationValue(strings.Repeat("a", 4097)),
+				},
+			},
+		},
+		{
+			name:       "invalid because too many labels",
+			wantErrors: []string{"spec.infrastructure.labels: Too many: 9: must have at most 8 items"},
+			infrastructure: &gatewayv1b1.GatewayInfrastructure{
+				Labels: func() map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue {
+					labels := map[gatewayv1b1.AnnotationKey]gatewayv1b1.AnnotationValue{}
+					for i := 0; i < 9; i++ {
+						labels[gatewayv1b1.AnnotationKey(fmt.Sprintf("example.com/label-%d", i))] = "foo"
+					}
+					return labels
+				}(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gw := &gatewayv1b1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("foo-%v", time.Now().UnixNano()),
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: gatewayv1b1.GatewaySpec{
+					GatewayClassName: "foo",
+					Listeners: []gatewayv1b1.Listener{{
+						Name:     gatewayv1b1.SectionName("http"),
+						Protocol: gatewayv1b1.HTTPProtocolType,
+						Port:     gatewayv1b1.PortNumber(80),
+					}},
+					Infrastructure: tc.infrastructure,
+				},
+			}
+			validateGateway(t, gw, tc.wantErrors)
+		})
+	}
+}
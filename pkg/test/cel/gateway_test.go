@@ -511,3 +511,24 @@ func TestValidateGateway(t *testing.T) {
 		})
 	}
 }
+
+func validateGateway(t *testing.T, gw *gatewayv1b1.Gateway, wantErrors []string) {
+	t.Helper()
+
+	ctx := context.Background()
+	err := k8sClient.Create(ctx, gw)
+
+	if (len(wantErrors) != 0) != (err != nil) {
+		t.Fatalf("Unexpected response while creating Gateway %q; got err=\n%v\n;want error=%v", fmt.Sprintf("%v/%v", gw.Namespace, gw.Name), err, wantErrors)
+	}
+
+	var missingErrorStrings []string
+	for _, wantError := range wantErrors {
+		if !strings.Contains(strings.ToLower(err.Error()), strings.ToLower(wantError)) {
+			missingErrorStrings = append(missingErrorStrings, wantError)
+		}
+	}
+	if len(missingErrorStrings) != 0 {
+		t.Errorf("Unexpected response while creating Gateway %q; got err=\n%v\n;missing strings within error=%q", fmt.Sprintf("%v/%v", gw.Namespace, gw.Name), err, missingErrorStrings)
+	}
+}
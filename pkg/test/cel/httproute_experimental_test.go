@@ -126,3 +126,74 @@ func TestHTTPRouteParentRefExperimental(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPRouteRetryExperimental(t *testing.T) {
+	tests := []struct {
+		name       string
+		wantErrors []string
+		retry      gatewayv1b1.HTTPRouteRetry
+	}{
+		{
+			name: "valid retry",
+			retry: gatewayv1b1.HTTPRouteRetry{
+				Attempts:      ptrTo(int32(3)),
+				PerTryTimeout: ptrTo(gatewayv1b1.Duration("1s")),
+				Codes:         []gatewayv1b1.HTTPRouteRetryStatusCode{502, 503},
+				Backoff:       ptrTo(gatewayv1b1.Duration("100ms")),
+			},
+		},
+		{
+			name:       "invalid because attempts is too large",
+			wantErrors: []string{"attempts must be between 0 and 16"},
+			retry: gatewayv1b1.HTTPRouteRetry{
+				Attempts: ptrTo(int32(17)),
+			},
+		},
+		{
+			name:       "invalid because attempts is negative",
+			wantErrors: []string{"attempts must be between 0 and 16"},
+			retry: gatewayv1b1.HTTPRouteRetry{
+				Attempts: ptrTo(int32(-1)),
+			},
+		},
+		{
+			name:       "invalid because backoff is set with retries disabled",
+			wantErrors: []string{"backoff must not be set when attempts is 0"},
+			retry: gatewayv1b1.HTTPRouteRetry{
+				Attempts: ptrTo(int32(0)),
+				Backoff:  ptrTo(gatewayv1b1.Duration("1s")),
+			},
+		},
+		{
+			name:       "invalid because per try timeout is not a GEP-2257 duration",
+			wantErrors: []string{"spec.rules[0].retry.perTryTimeout"},
+			retry: gatewayv1b1.HTTPRouteRetry{
+				PerTryTimeout: ptrTo(gatewayv1b1.Duration("1.5s")),
+			},
+		},
+		{
+			name:       "invalid because status code is out of range",
+			wantErrors: []string{"spec.rules[0].retry.codes[0]"},
+			retry: gatewayv1b1.HTTPRouteRetry{
+				Codes: []gatewayv1b1.HTTPRouteRetryStatusCode{200},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			route := &gatewayv1b1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      fmt.Sprintf("foo-%v", time.Now().UnixNano()),
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: gatewayv1b1.HTTPRouteSpec{
+					Rules: []gatewayv1b1.HTTPRouteRule{{
+						Retry: &tc.retry,
+					}},
+				},
+			}
+			validateHTTPRoute(t, route, tc.wantErrors)
+		})
+	}
+}
@@ -210,6 +210,25 @@ func TestBackendObjectReference(t *testing.T) {
 				Name:  "backend",
 			},
 		},
+		{
+			name: "serviceimport with port",
+			backendRef: gatewayv1b1.BackendObjectReference{
+				Group: groupPtr("multicluster.x-k8s.io"),
+				Kind:  kindPtr("ServiceImport"),
+				Name:  "backend",
+				Port:  portPtr(99),
+			},
+		},
+		{
+			// The port of a ServiceImport reference is checked by the webhook,
+			// so that objects stored before it was required stay valid.
+			name: "serviceimport with no port",
+			backendRef: gatewayv1b1.BackendObjectReference{
+				Group: groupPtr("multicluster.x-k8s.io"),
+				Kind:  kindPtr("ServiceImport"),
+				Name:  "backend",
+			},
+		},
 	}
 
 	for _, tc := range tests {